@@ -0,0 +1,20 @@
+package core
+
+import "errors"
+
+var (
+	// ErrNoMoreData is returned by an Iterator once it is exhausted.
+	ErrNoMoreData = errors.New("no more data")
+
+	// ErrInvalidType is returned when an operation receives a value of an
+	// unexpected runtime Type.
+	ErrInvalidType = errors.New("invalid type")
+
+	// ErrInvalidIndex is returned when an index-based operation is out of
+	// bounds.
+	ErrInvalidIndex = errors.New("invalid index")
+
+	// ErrInvalidArgumentsCount is returned by a built-in function when it is
+	// called with the wrong number of arguments.
+	ErrInvalidArgumentsCount = errors.New("invalid number of arguments")
+)
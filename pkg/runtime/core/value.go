@@ -0,0 +1,71 @@
+package core
+
+import (
+	"context"
+	"fmt"
+)
+
+// Type represents a runtime type of a FQL value.
+type Type int
+
+const (
+	NoneType Type = iota
+	BooleanType
+	IntType
+	FloatType
+	StringType
+	BinaryType
+	ArrayType
+	ObjectType
+	SetType
+)
+
+func (t Type) String() string {
+	switch t {
+	case NoneType:
+		return "None"
+	case BooleanType:
+		return "Boolean"
+	case IntType:
+		return "Int"
+	case FloatType:
+		return "Float"
+	case StringType:
+		return "String"
+	case BinaryType:
+		return "Binary"
+	case ArrayType:
+		return "Array"
+	case ObjectType:
+		return "Object"
+	case SetType:
+		return "Set"
+	default:
+		return "Unknown"
+	}
+}
+
+// Value is the base interface implemented by every runtime value produced
+// or consumed by an FQL program.
+type Value interface {
+	fmt.Stringer
+
+	// MarshalJSON returns the JSON representation of the value.
+	MarshalJSON() ([]byte, error)
+
+	// Type returns the runtime type of the value.
+	Type() Type
+
+	// Compare returns -1, 0 or 1 depending on whether the value is less than,
+	// equal to, or greater than the other value.
+	Compare(other Value) int64
+
+	// Unwrap returns the plain Go representation of the value.
+	Unwrap() interface{}
+}
+
+// Iterator produces a sequence of values consumed by FOR loops.
+// Next returns ErrNoMoreData as its error once the sequence is exhausted.
+type Iterator interface {
+	Next(ctx context.Context) (value Value, key Value, err error)
+}
@@ -0,0 +1,215 @@
+package values
+
+import (
+	"context"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values/coll"
+)
+
+// Array represents the FQL Array value. It is a thin wrapper over a
+// coll.Vector[core.Value], kept around mainly for its JSON/Compare/String
+// semantics - the generic container does the actual storage work.
+type Array struct {
+	vector *coll.Vector[core.Value]
+}
+
+// NewArray creates an empty array with the given initial capacity.
+func NewArray(size int) *Array {
+	return &Array{vector: coll.NewVector[core.Value](size)}
+}
+
+// NewArrayWith creates an array populated with the given values.
+func NewArrayWith(values ...core.Value) *Array {
+	return &Array{vector: coll.NewVectorWith(values...)}
+}
+
+// Raw exposes the underlying generic container so extension packages can
+// build iterators and transforms over the array without paying the cost of
+// repeated Unwrap() boxing.
+func (t *Array) Raw() *coll.Vector[core.Value] {
+	return t.vector
+}
+
+func (t *Array) MarshalJSON() ([]byte, error) {
+	var b strings.Builder
+
+	b.WriteByte('[')
+
+	t.ForEach(func(value core.Value, idx int) bool {
+		if idx > 0 {
+			b.WriteByte(',')
+		}
+
+		bytes, err := value.MarshalJSON()
+
+		if err == nil {
+			b.Write(bytes)
+		}
+
+		return true
+	})
+
+	b.WriteByte(']')
+
+	return []byte(b.String()), nil
+}
+
+func (t *Array) Type() core.Type {
+	return core.ArrayType
+}
+
+func (t *Array) String() string {
+	return t.vector.String()
+}
+
+func (t *Array) Compare(other core.Value) int64 {
+	switch other.Type() {
+	case core.ArrayType:
+		otherArr := other.(*Array)
+
+		thisLen := t.Length()
+		otherLen := otherArr.Length()
+
+		if thisLen == otherLen {
+			for i := 0; i < thisLen; i++ {
+				cmp := t.Get(i).Compare(otherArr.Get(i))
+
+				if cmp != 0 {
+					return cmp
+				}
+			}
+
+			return 0
+		} else if thisLen < otherLen {
+			return -1
+		}
+
+		return 1
+	case core.ObjectType:
+		return -1
+	default:
+		return compareByType(t, other)
+	}
+}
+
+func (t *Array) Unwrap() interface{} {
+	out := make([]interface{}, t.Length())
+
+	for i, v := range t.vector.Values() {
+		out[i] = v.Unwrap()
+	}
+
+	return out
+}
+
+// Length returns the number of elements in the array.
+func (t *Array) Length() int {
+	return t.vector.Size()
+}
+
+// ForEach iterates over the array, stopping when the callback returns
+// false. It drives NewArrayIterator rather than t.vector.ForEach directly,
+// so its consumers (MarshalJSON, for one) go through the same core.Iterator
+// protocol a FOR loop would.
+func (t *Array) ForEach(predicate func(value core.Value, idx int) bool) {
+	iter := NewArrayIterator(t)
+	ctx := context.Background()
+
+	for {
+		value, key, err := iter.Next(ctx)
+
+		if err != nil {
+			return
+		}
+
+		if !predicate(value, int(key.(Int))) {
+			return
+		}
+	}
+}
+
+// Get returns the element at idx, or None when idx is out of bounds.
+func (t *Array) Get(idx int) core.Value {
+	v, found := t.vector.Get(idx)
+
+	if !found {
+		return None
+	}
+
+	return v
+}
+
+// Set replaces the element at idx. It returns an error when idx is out of
+// bounds.
+func (t *Array) Set(idx int, value core.Value) error {
+	if !t.vector.Set(idx, value) {
+		return core.ErrInvalidIndex
+	}
+
+	return nil
+}
+
+// Push appends value to the end of the array.
+func (t *Array) Push(value core.Value) {
+	t.vector.Push(value)
+}
+
+// Insert places value at idx, shifting subsequent elements to the right.
+func (t *Array) Insert(idx int, value core.Value) {
+	t.vector.Insert(idx, value)
+}
+
+// RemoveAt removes the element at idx, if any.
+func (t *Array) RemoveAt(idx int) {
+	t.vector.RemoveAt(idx)
+}
+
+// Slice returns a sub-slice of the array's elements in the range [from, to).
+func (t *Array) Slice(from, to int) []core.Value {
+	return t.vector.Slice(from, to)
+}
+
+func (t *Array) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(t.Unwrap())
+}
+
+func (t *Array) UnmarshalCBOR(data []byte) error {
+	var raw []interface{}
+
+	if err := cbor.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	t.vector = coll.NewVector[core.Value](len(raw))
+
+	for _, item := range raw {
+		t.vector.Push(Parse(normalizeDecoded(item)))
+	}
+
+	return nil
+}
+
+func (t *Array) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(t.Unwrap())
+}
+
+func (t *Array) UnmarshalMsgpack(data []byte) error {
+	var raw []interface{}
+
+	if err := msgpack.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	t.vector = coll.NewVector[core.Value](len(raw))
+
+	for _, item := range raw {
+		t.vector.Push(Parse(normalizeDecoded(item)))
+	}
+
+	return nil
+}
@@ -117,6 +117,14 @@ func TestArray(t *testing.T) {
 
 			So(arr1.Compare(arr2), ShouldEqual, 1)
 		})
+
+		Convey("It should be antisymmetric against a Set", func() {
+			arr := values.NewArrayWith(values.ZeroInt)
+			set := values.NewSetWith(values.ZeroInt)
+
+			So(arr.Compare(set), ShouldEqual, -1)
+			So(set.Compare(arr), ShouldEqual, 1)
+		})
 	})
 
 	Convey(".Length", t, func() {
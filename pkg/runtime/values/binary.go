@@ -0,0 +1,91 @@
+package values
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// Binary holds raw, possibly non-UTF8 byte data - e.g. the output of
+// Encode for the "cbor" and "msgpack" formats. Unlike String, whose
+// MarshalJSON Go-syntax-quotes its bytes (and so can emit escapes JSON
+// doesn't understand, like \xff), Binary always marshals to a valid JSON
+// string by base64-encoding its contents.
+type Binary []byte
+
+// NewBinary creates a Binary value from a copy of val.
+func NewBinary(val []byte) Binary {
+	b := make(Binary, len(val))
+
+	copy(b, val)
+
+	return b
+}
+
+func (t Binary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(base64.StdEncoding.EncodeToString(t))
+}
+
+func (t Binary) Type() core.Type {
+	return core.BinaryType
+}
+
+func (t Binary) String() string {
+	return base64.StdEncoding.EncodeToString(t)
+}
+
+func (t Binary) Compare(other core.Value) int64 {
+	if other.Type() != core.BinaryType {
+		return compareByType(t, other)
+	}
+
+	switch cmp := bytes.Compare(t, other.(Binary)); {
+	case cmp < 0:
+		return -1
+	case cmp > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (t Binary) Unwrap() interface{} {
+	return []byte(t)
+}
+
+func (t Binary) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal([]byte(t))
+}
+
+func (t *Binary) UnmarshalCBOR(data []byte) error {
+	var val []byte
+
+	if err := cbor.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*t = val
+
+	return nil
+}
+
+func (t Binary) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal([]byte(t))
+}
+
+func (t *Binary) UnmarshalMsgpack(data []byte) error {
+	var val []byte
+
+	if err := msgpack.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*t = val
+
+	return nil
+}
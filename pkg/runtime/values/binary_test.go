@@ -0,0 +1,55 @@
+package values_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBinary(t *testing.T) {
+	Convey(".Type", t, func() {
+		Convey("Should return BinaryType", func() {
+			So(values.NewBinary([]byte{1, 2, 3}).Type(), ShouldEqual, core.BinaryType)
+		})
+	})
+
+	Convey(".MarshalJSON", t, func() {
+		Convey("Should base64-encode its contents, producing valid JSON", func() {
+			raw := []byte{0xff, 0x00, 0x10}
+			b := values.NewBinary(raw)
+
+			marshaled, err := b.MarshalJSON()
+			So(err, ShouldBeNil)
+
+			var decoded string
+			So(json.Unmarshal(marshaled, &decoded), ShouldBeNil)
+
+			rawOut, err := base64.StdEncoding.DecodeString(decoded)
+			So(err, ShouldBeNil)
+			So(rawOut, ShouldResemble, raw)
+		})
+	})
+
+	Convey(".Compare", t, func() {
+		Convey("Should compare lexicographically", func() {
+			a := values.NewBinary([]byte{1})
+			b := values.NewBinary([]byte{2})
+
+			So(a.Compare(b), ShouldEqual, -1)
+			So(b.Compare(a), ShouldEqual, 1)
+			So(a.Compare(a), ShouldEqual, 0)
+		})
+	})
+
+	Convey(".Unwrap", t, func() {
+		Convey("Should return the underlying bytes", func() {
+			raw := []byte{1, 2, 3}
+
+			So(values.NewBinary(raw).Unwrap(), ShouldResemble, raw)
+		})
+	})
+}
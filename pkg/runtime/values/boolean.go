@@ -0,0 +1,85 @@
+package values
+
+import (
+	"strconv"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+type Boolean bool
+
+const (
+	True  = Boolean(true)
+	False = Boolean(false)
+)
+
+func NewBoolean(val bool) Boolean {
+	return Boolean(val)
+}
+
+func (t Boolean) MarshalJSON() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t Boolean) Type() core.Type {
+	return core.BooleanType
+}
+
+func (t Boolean) String() string {
+	return strconv.FormatBool(bool(t))
+}
+
+func (t Boolean) Compare(other core.Value) int64 {
+	if other.Type() != core.BooleanType {
+		return compareByType(t, other)
+	}
+
+	otherVal := other.(Boolean)
+
+	if t == otherVal {
+		return 0
+	} else if !bool(t) {
+		return -1
+	}
+
+	return 1
+}
+
+func (t Boolean) Unwrap() interface{} {
+	return bool(t)
+}
+
+func (t Boolean) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(bool(t))
+}
+
+func (t *Boolean) UnmarshalCBOR(data []byte) error {
+	var val bool
+
+	if err := cbor.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*t = Boolean(val)
+
+	return nil
+}
+
+func (t Boolean) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(bool(t))
+}
+
+func (t *Boolean) UnmarshalMsgpack(data []byte) error {
+	var val bool
+
+	if err := msgpack.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*t = Boolean(val)
+
+	return nil
+}
@@ -0,0 +1,290 @@
+package values
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// Supported Encode/Decode formats.
+const (
+	FormatJSON    = "json"
+	FormatCBOR    = "cbor"
+	FormatMsgpack = "msgpack"
+)
+
+// Encode serializes v using the given format, so scripts can exchange
+// binary data with external systems (message queues, on-disk caches) far
+// more compactly than the JSON produced by MarshalJSON.
+func Encode(v core.Value, format string) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return v.MarshalJSON()
+	case FormatCBOR:
+		return cbor.Marshal(v.Unwrap())
+	case FormatMsgpack:
+		return msgpack.Marshal(v.Unwrap())
+	default:
+		return nil, fmt.Errorf("unsupported encoding format: %s", format)
+	}
+}
+
+// Decode deserializes data produced by Encode (or a compatible external
+// encoder) back into a core.Value.
+func Decode(data []byte, format string) (core.Value, error) {
+	switch format {
+	case FormatJSON:
+		var raw interface{}
+
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+
+		return Parse(raw), nil
+	case FormatCBOR:
+		var raw interface{}
+
+		if err := cbor.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+
+		return Parse(normalizeDecoded(raw)), nil
+	case FormatMsgpack:
+		if err := validateMsgpackBounds(data); err != nil {
+			return nil, err
+		}
+
+		var raw interface{}
+
+		if err := msgpack.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+
+		return Parse(normalizeDecoded(raw)), nil
+	default:
+		return nil, fmt.Errorf("unsupported encoding format: %s", format)
+	}
+}
+
+// normalizeDecoded coerces the map shape produced by the CBOR and msgpack
+// decoders (map[interface{}]interface{}) into map[string]interface{}, and
+// recurses into nested maps/slices. Integers are deliberately left as
+// int64/uint64 rather than widened to float64 - unlike JSON, both CBOR and
+// msgpack distinguish integers from floats on the wire, and Parse preserves
+// that distinction as values.Int vs values.Float.
+func normalizeDecoded(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(val))
+
+		for k, item := range val {
+			out[fmt.Sprintf("%v", k)] = normalizeDecoded(item)
+		}
+
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(val))
+
+		for k, item := range val {
+			out[k] = normalizeDecoded(item)
+		}
+
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(val))
+
+		for i, item := range val {
+			out[i] = normalizeDecoded(item)
+		}
+
+		return out
+	default:
+		return val
+	}
+}
+
+// errMsgpackTruncated is returned by validateMsgpackBounds when a
+// length-prefixed value's declared size runs past the end of the buffer.
+var errMsgpackTruncated = errors.New("msgpack: truncated or corrupt length header")
+
+// validateMsgpackBounds walks data's structure purely off its length
+// headers, without allocating any of the containers or values they
+// describe, and rejects any array/map/string/bin/ext whose declared size
+// exceeds what's actually left in the buffer.
+//
+// msgpack.Unmarshal trusts those headers and preallocates accordingly
+// *before* it has read enough of the stream to know whether they're
+// plausible - decodeSlice in particular preallocates a []interface{} of
+// the declared length with no ceiling at all, so a single crafted Array32
+// header claiming ~800M elements forces a multi-gigabyte allocation and
+// OOM-kills the process on an 8-byte input. Every element needs at least
+// one byte to encode, so a declared count greater than the remaining bytes
+// can never be legitimate - this rejects it up front instead of handing it
+// to msgpack.Unmarshal.
+func validateMsgpackBounds(data []byte) error {
+	_, err := skipMsgpackValue(data, 0)
+
+	return err
+}
+
+func skipMsgpackValue(data []byte, pos int) (int, error) {
+	if pos >= len(data) {
+		return 0, errMsgpackTruncated
+	}
+
+	c := data[pos]
+	pos++
+
+	switch {
+	case msgpcode.IsFixedNum(c):
+		return pos, nil
+	case msgpcode.IsFixedMap(c):
+		return skipMsgpackEntries(data, pos, int(c&msgpcode.FixedMapMask), 2)
+	case msgpcode.IsFixedArray(c):
+		return skipMsgpackEntries(data, pos, int(c&msgpcode.FixedArrayMask), 1)
+	case msgpcode.IsFixedString(c):
+		return skipMsgpackBytes(data, pos, int(c&msgpcode.FixedStrMask))
+	}
+
+	switch c {
+	case msgpcode.Nil, msgpcode.False, msgpcode.True:
+		return pos, nil
+	case msgpcode.Float:
+		return skipMsgpackBytes(data, pos, 4)
+	case msgpcode.Double:
+		return skipMsgpackBytes(data, pos, 8)
+	case msgpcode.Uint8, msgpcode.Int8:
+		return skipMsgpackBytes(data, pos, 1)
+	case msgpcode.Uint16, msgpcode.Int16:
+		return skipMsgpackBytes(data, pos, 2)
+	case msgpcode.Uint32, msgpcode.Int32:
+		return skipMsgpackBytes(data, pos, 4)
+	case msgpcode.Uint64, msgpcode.Int64:
+		return skipMsgpackBytes(data, pos, 8)
+	case msgpcode.Str8, msgpcode.Bin8:
+		return skipMsgpackLenPrefixed(data, pos, 1)
+	case msgpcode.Str16, msgpcode.Bin16:
+		return skipMsgpackLenPrefixed(data, pos, 2)
+	case msgpcode.Str32, msgpcode.Bin32:
+		return skipMsgpackLenPrefixed(data, pos, 4)
+	case msgpcode.Array16:
+		return skipMsgpackSizedEntries(data, pos, 2, 1)
+	case msgpcode.Array32:
+		return skipMsgpackSizedEntries(data, pos, 4, 1)
+	case msgpcode.Map16:
+		return skipMsgpackSizedEntries(data, pos, 2, 2)
+	case msgpcode.Map32:
+		return skipMsgpackSizedEntries(data, pos, 4, 2)
+	case msgpcode.FixExt1:
+		return skipMsgpackBytes(data, pos, 1+1)
+	case msgpcode.FixExt2:
+		return skipMsgpackBytes(data, pos, 1+2)
+	case msgpcode.FixExt4:
+		return skipMsgpackBytes(data, pos, 1+4)
+	case msgpcode.FixExt8:
+		return skipMsgpackBytes(data, pos, 1+8)
+	case msgpcode.FixExt16:
+		return skipMsgpackBytes(data, pos, 1+16)
+	case msgpcode.Ext8:
+		return skipMsgpackLenPrefixedExt(data, pos, 1)
+	case msgpcode.Ext16:
+		return skipMsgpackLenPrefixedExt(data, pos, 2)
+	case msgpcode.Ext32:
+		return skipMsgpackLenPrefixedExt(data, pos, 4)
+	}
+
+	return 0, fmt.Errorf("msgpack: unknown code %x while validating bounds", c)
+}
+
+// readMsgpackUint reads a big-endian unsigned length header of width bytes.
+func readMsgpackUint(data []byte, pos, width int) (uint64, int, error) {
+	if pos+width > len(data) {
+		return 0, 0, errMsgpackTruncated
+	}
+
+	var n uint64
+
+	for i := 0; i < width; i++ {
+		n = n<<8 | uint64(data[pos+i])
+	}
+
+	return n, pos + width, nil
+}
+
+// skipMsgpackBytes advances pos past n raw bytes, rejecting n that runs
+// past the end of data.
+func skipMsgpackBytes(data []byte, pos, n int) (int, error) {
+	if n < 0 || n > len(data)-pos {
+		return 0, errMsgpackTruncated
+	}
+
+	return pos + n, nil
+}
+
+// skipMsgpackLenPrefixed skips a str/bin payload whose byte length is
+// encoded in the lenWidth bytes at pos.
+func skipMsgpackLenPrefixed(data []byte, pos, lenWidth int) (int, error) {
+	n, next, err := readMsgpackUint(data, pos, lenWidth)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return skipMsgpackBytes(data, next, int(n))
+}
+
+// skipMsgpackLenPrefixedExt skips an ext payload whose byte length is
+// encoded in the lenWidth bytes at pos, plus the type byte that follows it.
+func skipMsgpackLenPrefixedExt(data []byte, pos, lenWidth int) (int, error) {
+	n, next, err := readMsgpackUint(data, pos, lenWidth)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return skipMsgpackBytes(data, next, int(n)+1)
+}
+
+// skipMsgpackSizedEntries reads a count header of lenWidth bytes, then
+// skips count*valuesPerEntry values (1 for an array element, 2 for a
+// map key/value pair).
+func skipMsgpackSizedEntries(data []byte, pos, lenWidth, valuesPerEntry int) (int, error) {
+	n, next, err := readMsgpackUint(data, pos, lenWidth)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return skipMsgpackEntries(data, next, int(n), valuesPerEntry)
+}
+
+// skipMsgpackEntries skips count*valuesPerEntry values starting at pos.
+// Every value needs at least one byte to encode, so a count whose
+// entry total exceeds the remaining buffer is rejected up front - this is
+// what stops a hostile length header from ever reaching msgpack.Unmarshal's
+// unchecked preallocation.
+func skipMsgpackEntries(data []byte, pos, count, valuesPerEntry int) (int, error) {
+	if count < 0 || count > (len(data)-pos)/valuesPerEntry {
+		return 0, errMsgpackTruncated
+	}
+
+	total := count * valuesPerEntry
+
+	for i := 0; i < total; i++ {
+		var err error
+
+		pos, err = skipMsgpackValue(data, pos)
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return pos, nil
+}
@@ -0,0 +1,43 @@
+package values_test
+
+import (
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// FuzzDecodeJSON checks that Decode never panics on arbitrary input, no
+// matter how malformed.
+func FuzzDecodeJSON(f *testing.F) {
+	f.Add([]byte(`{"a":1,"b":[1,2,3]}`))
+	f.Add([]byte(`[]`))
+	f.Add([]byte(`null`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = values.Decode(data, values.FormatJSON)
+	})
+}
+
+func FuzzDecodeCBOR(f *testing.F) {
+	seed, _ := values.Encode(values.NewArrayWith(values.NewInt(1), values.NewString("x")), values.FormatCBOR)
+	f.Add(seed)
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = values.Decode(data, values.FormatCBOR)
+	})
+}
+
+func FuzzDecodeMsgpack(f *testing.F) {
+	seed, _ := values.Encode(values.NewArrayWith(values.NewInt(1), values.NewString("x")), values.FormatMsgpack)
+	f.Add(seed)
+	f.Add([]byte{})
+	// A nested Array32 header claiming ~800M elements backed by only a
+	// few bytes - used to force a multi-gigabyte allocation and OOM-kill
+	// the process instead of returning an error.
+	f.Add([]byte("\x9a0\x91\xdd0\x8000"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = values.Decode(data, values.FormatMsgpack)
+	})
+}
@@ -0,0 +1,67 @@
+package values_test
+
+import (
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	Convey("Should round-trip an array through every supported format", t, func() {
+		src := values.NewArrayWith(
+			values.NewInt(1),
+			values.NewString("two"),
+			values.True,
+			values.None,
+		)
+
+		for _, format := range []string{values.FormatJSON, values.FormatCBOR, values.FormatMsgpack} {
+			Convey("format: "+format, func() {
+				data, err := values.Encode(src, format)
+				So(err, ShouldBeNil)
+
+				out, err := values.Decode(data, format)
+				So(err, ShouldBeNil)
+
+				So(src.Compare(out), ShouldEqual, 0)
+			})
+		}
+	})
+
+	Convey("Should round-trip an object through every supported format", t, func() {
+		src := values.NewObject()
+		src.Set("a", values.NewInt(1))
+		src.Set("b", values.NewFloat(2.5))
+
+		for _, format := range []string{values.FormatJSON, values.FormatCBOR, values.FormatMsgpack} {
+			Convey("format: "+format, func() {
+				data, err := values.Encode(src, format)
+				So(err, ShouldBeNil)
+
+				out, err := values.Decode(data, format)
+				So(err, ShouldBeNil)
+
+				So(src.Compare(out), ShouldEqual, 0)
+			})
+		}
+	})
+
+	Convey("Should return an error for an unsupported format", t, func() {
+		_, err := values.Encode(values.NewInt(1), "yaml")
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should reject a msgpack payload whose length header exceeds the buffer instead of allocating it", t, func() {
+		// A nested Array32 header claiming ~800M elements with only a
+		// few bytes actually behind it - msgpack.Unmarshal would
+		// preallocate a multi-gigabyte []interface{} for this before
+		// noticing the buffer is short.
+		payload := []byte("\x9a0\x91\xdd0\x8000")
+
+		_, err := values.Decode(payload, values.FormatMsgpack)
+
+		So(err, ShouldNotBeNil)
+	})
+}
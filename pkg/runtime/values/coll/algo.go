@@ -0,0 +1,94 @@
+package coll
+
+import "sort"
+
+// MapSlice applies fn to every element of src and returns a new slice of the
+// results. It is named MapSlice, not Map, to avoid colliding with the
+// Map[K,V] container type in this same package.
+func MapSlice[T, R any](src []T, fn func(T) R) []R {
+	out := make([]R, len(src))
+
+	for i, v := range src {
+		out[i] = fn(v)
+	}
+
+	return out
+}
+
+// Filter returns the elements of src for which predicate returns true.
+func Filter[T any](src []T, predicate func(T) bool) []T {
+	out := make([]T, 0, len(src))
+
+	for _, v := range src {
+		if predicate(v) {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// Reduce folds src into a single accumulated value, starting from initial.
+func Reduce[T, R any](src []T, initial R, fn func(acc R, cur T) R) R {
+	acc := initial
+
+	for _, v := range src {
+		acc = fn(acc, v)
+	}
+
+	return acc
+}
+
+// Take returns at most n leading elements of src.
+func Take[T any](src []T, n int) []T {
+	if n < 0 {
+		n = 0
+	}
+
+	if n > len(src) {
+		n = len(src)
+	}
+
+	out := make([]T, n)
+
+	copy(out, src[:n])
+
+	return out
+}
+
+// Any reports whether predicate returns true for at least one element of
+// src.
+func Any[T any](src []T, predicate func(T) bool) bool {
+	for _, v := range src {
+		if predicate(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// All reports whether predicate returns true for every element of src. It
+// returns true for an empty slice.
+func All[T any](src []T, predicate func(T) bool) bool {
+	for _, v := range src {
+		if !predicate(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SortedValues returns a sorted copy of src, ordered by cmp.
+func SortedValues[T any](src []T, cmp Comparator[T]) []T {
+	out := make([]T, len(src))
+
+	copy(out, src)
+
+	sort.Slice(out, func(i, j int) bool {
+		return cmp(out[i], out[j]) < 0
+	})
+
+	return out
+}
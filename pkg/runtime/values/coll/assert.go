@@ -0,0 +1,17 @@
+package coll
+
+import "github.com/MontFerret/ferret/pkg/runtime/values/coll/containers"
+
+// These assertions make sure Vector, Map, Set and PriorityQueue keep
+// satisfying containers.Container as they evolve. Container itself has no
+// callers yet - generic algorithms and the FOR-loop iteration protocol it
+// was added for still consume these collections through their concrete
+// types rather than through the interface - so without this file nothing in
+// the tree ever instantiated it, and a change quietly breaking the contract
+// would only surface wherever a future caller tried to use it.
+var (
+	_ containers.Container[int] = (*Vector[int])(nil)
+	_ containers.Container[int] = (*Map[string, int])(nil)
+	_ containers.Container[int] = (*Set[int])(nil)
+	_ containers.Container[int] = (*PriorityQueue[int])(nil)
+)
@@ -0,0 +1,22 @@
+// Package containers defines the common interface implemented by every
+// generic collection in pkg/runtime/values/coll.
+package containers
+
+// Container is the common surface implemented by Vector, Map, Set and
+// PriorityQueue, so algorithms and callers can work with any of them
+// generically.
+type Container[T any] interface {
+	// Empty returns true when the container holds no elements.
+	Empty() bool
+
+	// Size returns the number of elements currently stored.
+	Size() int
+
+	// Clear removes all elements from the container.
+	Clear()
+
+	// Values returns a snapshot slice of the container's elements.
+	Values() []T
+
+	String() string
+}
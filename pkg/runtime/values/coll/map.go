@@ -0,0 +1,122 @@
+package coll
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Map is a generic, insertion-order-preserving associative container. It is
+// the storage backend for values.Object.
+type Map[K comparable, V any] struct {
+	data map[K]V
+	keys []K
+}
+
+// NewMap creates an empty Map with the given initial capacity.
+func NewMap[K comparable, V any](cap int) *Map[K, V] {
+	return &Map[K, V]{
+		data: make(map[K]V, cap),
+		keys: make([]K, 0, cap),
+	}
+}
+
+func (m *Map[K, V]) Empty() bool {
+	return len(m.keys) == 0
+}
+
+func (m *Map[K, V]) Size() int {
+	return len(m.keys)
+}
+
+func (m *Map[K, V]) Clear() {
+	m.data = make(map[K]V)
+	m.keys = m.keys[:0]
+}
+
+// Values returns the values in insertion order.
+func (m *Map[K, V]) Values() []V {
+	out := make([]V, 0, len(m.keys))
+
+	for _, k := range m.keys {
+		out = append(out, m.data[k])
+	}
+
+	return out
+}
+
+// Keys returns the keys in insertion order.
+func (m *Map[K, V]) Keys() []K {
+	out := make([]K, len(m.keys))
+
+	copy(out, m.keys)
+
+	return out
+}
+
+// Get returns the value stored under key, if present.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	v, found := m.data[key]
+
+	return v, found
+}
+
+// Set stores value under key, preserving the original insertion position
+// when the key already exists.
+func (m *Map[K, V]) Set(key K, value V) {
+	if _, found := m.data[key]; !found {
+		m.keys = append(m.keys, key)
+	}
+
+	m.data[key] = value
+}
+
+// Remove deletes key from the map, if present.
+func (m *Map[K, V]) Remove(key K) {
+	if _, found := m.data[key]; !found {
+		return
+	}
+
+	delete(m.data, key)
+
+	for i, k := range m.keys {
+		if k == key {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Has reports whether key exists in the map.
+func (m *Map[K, V]) Has(key K) bool {
+	_, found := m.data[key]
+
+	return found
+}
+
+// ForEach iterates over the map in insertion order, stopping when the
+// callback returns false.
+func (m *Map[K, V]) ForEach(predicate func(value V, key K) bool) {
+	for _, k := range m.keys {
+		if !predicate(m.data[k], k) {
+			break
+		}
+	}
+}
+
+func (m *Map[K, V]) String() string {
+	var b strings.Builder
+
+	b.WriteByte('{')
+
+	for i, k := range m.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		b.WriteString(fmt.Sprintf("%v:%v", k, m.data[k]))
+	}
+
+	b.WriteByte('}')
+
+	return b.String()
+}
@@ -0,0 +1,98 @@
+package coll_test
+
+import (
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values/coll"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMap(t *testing.T) {
+	Convey("#constructor", t, func() {
+		Convey("Should create an empty map", func() {
+			m := coll.NewMap[string, int](10)
+
+			So(m.Size(), ShouldEqual, 0)
+			So(m.Empty(), ShouldBeTrue)
+		})
+	})
+
+	Convey(".Set/.Get", t, func() {
+		Convey("Should store and retrieve a value", func() {
+			m := coll.NewMap[string, int](10)
+
+			m.Set("a", 1)
+
+			val, found := m.Get("a")
+
+			So(found, ShouldBeTrue)
+			So(val, ShouldEqual, 1)
+		})
+
+		Convey("Should not duplicate keys on overwrite", func() {
+			m := coll.NewMap[string, int](10)
+
+			m.Set("a", 1)
+			m.Set("a", 2)
+
+			So(m.Size(), ShouldEqual, 1)
+
+			val, _ := m.Get("a")
+
+			So(val, ShouldEqual, 2)
+		})
+
+		Convey("Should return false for a missing key", func() {
+			m := coll.NewMap[string, int](10)
+
+			_, found := m.Get("missing")
+
+			So(found, ShouldBeFalse)
+		})
+	})
+
+	Convey(".Keys", t, func() {
+		Convey("Should preserve insertion order", func() {
+			m := coll.NewMap[string, int](10)
+
+			m.Set("b", 2)
+			m.Set("a", 1)
+			m.Set("c", 3)
+
+			So(m.Keys(), ShouldResemble, []string{"b", "a", "c"})
+		})
+	})
+
+	Convey(".Remove", t, func() {
+		Convey("Should remove a key", func() {
+			m := coll.NewMap[string, int](10)
+
+			m.Set("a", 1)
+			m.Set("b", 2)
+			m.Remove("a")
+
+			So(m.Size(), ShouldEqual, 1)
+			So(m.Has("a"), ShouldBeFalse)
+			So(m.Keys(), ShouldResemble, []string{"b"})
+		})
+	})
+
+	Convey(".ForEach", t, func() {
+		Convey("Should iterate in insertion order", func() {
+			m := coll.NewMap[string, int](10)
+
+			m.Set("a", 1)
+			m.Set("b", 2)
+
+			var keys []string
+
+			m.ForEach(func(value int, key string) bool {
+				keys = append(keys, key)
+
+				return true
+			})
+
+			So(keys, ShouldResemble, []string{"a", "b"})
+		})
+	})
+}
@@ -0,0 +1,117 @@
+package coll
+
+import (
+	"container/heap"
+	"fmt"
+	"strings"
+)
+
+// Comparator returns a negative number when a orders before b, zero when
+// they are equal, and a positive number when a orders after b.
+type Comparator[T any] func(a, b T) int
+
+// PriorityQueue is a generic binary-heap priority queue ordered by a
+// user-supplied Comparator.
+type PriorityQueue[T any] struct {
+	h *pqHeap[T]
+}
+
+// NewPriorityQueue creates an empty PriorityQueue ordered by cmp.
+func NewPriorityQueue[T any](cmp Comparator[T]) *PriorityQueue[T] {
+	h := &pqHeap[T]{cmp: cmp}
+
+	heap.Init(h)
+
+	return &PriorityQueue[T]{h: h}
+}
+
+func (q *PriorityQueue[T]) Empty() bool {
+	return q.h.Len() == 0
+}
+
+func (q *PriorityQueue[T]) Size() int {
+	return q.h.Len()
+}
+
+func (q *PriorityQueue[T]) Clear() {
+	q.h.items = nil
+}
+
+func (q *PriorityQueue[T]) Values() []T {
+	out := make([]T, len(q.h.items))
+
+	copy(out, q.h.items)
+
+	return out
+}
+
+func (q *PriorityQueue[T]) String() string {
+	var b strings.Builder
+
+	b.WriteByte('[')
+
+	for i, item := range q.h.items {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		b.WriteString(fmt.Sprintf("%v", item))
+	}
+
+	b.WriteByte(']')
+
+	return b.String()
+}
+
+// Push adds value to the queue.
+func (q *PriorityQueue[T]) Push(value T) {
+	heap.Push(q.h, value)
+}
+
+// Pop removes and returns the highest-priority element. ok is false when
+// the queue is empty.
+func (q *PriorityQueue[T]) Pop() (value T, ok bool) {
+	if q.h.Len() == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return heap.Pop(q.h).(T), true
+}
+
+// Peek returns the highest-priority element without removing it.
+func (q *PriorityQueue[T]) Peek() (value T, ok bool) {
+	if q.h.Len() == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return q.h.items[0], true
+}
+
+// pqHeap adapts a slice and Comparator to container/heap.Interface.
+type pqHeap[T any] struct {
+	items []T
+	cmp   Comparator[T]
+}
+
+func (h *pqHeap[T]) Len() int { return len(h.items) }
+
+func (h *pqHeap[T]) Less(i, j int) bool { return h.cmp(h.items[i], h.items[j]) < 0 }
+
+func (h *pqHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *pqHeap[T]) Push(x interface{}) {
+	h.items = append(h.items, x.(T))
+}
+
+func (h *pqHeap[T]) Pop() interface{} {
+	old := h.items
+	n := len(old)
+	item := old[n-1]
+	h.items = old[:n-1]
+
+	return item
+}
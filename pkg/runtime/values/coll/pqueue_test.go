@@ -0,0 +1,115 @@
+package coll_test
+
+import (
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values/coll"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func intAsc(a, b int) int {
+	return a - b
+}
+
+func TestPriorityQueue(t *testing.T) {
+	Convey("#constructor", t, func() {
+		Convey("Should create an empty queue", func() {
+			q := coll.NewPriorityQueue[int](intAsc)
+
+			So(q.Size(), ShouldEqual, 0)
+			So(q.Empty(), ShouldBeTrue)
+		})
+	})
+
+	Convey(".Push/.Pop", t, func() {
+		Convey("Should pop elements in priority order", func() {
+			q := coll.NewPriorityQueue[int](intAsc)
+
+			q.Push(5)
+			q.Push(1)
+			q.Push(3)
+
+			var popped []int
+
+			for {
+				val, ok := q.Pop()
+
+				if !ok {
+					break
+				}
+
+				popped = append(popped, val)
+			}
+
+			So(popped, ShouldResemble, []int{1, 3, 5})
+			So(q.Size(), ShouldEqual, 0)
+		})
+
+		Convey("Should return false when the queue is empty", func() {
+			q := coll.NewPriorityQueue[int](intAsc)
+
+			_, ok := q.Pop()
+
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey(".Peek", t, func() {
+		Convey("Should return the highest-priority element without removing it", func() {
+			q := coll.NewPriorityQueue[int](intAsc)
+
+			q.Push(5)
+			q.Push(1)
+
+			val, ok := q.Peek()
+
+			So(ok, ShouldBeTrue)
+			So(val, ShouldEqual, 1)
+			So(q.Size(), ShouldEqual, 2)
+		})
+
+		Convey("Should return false when the queue is empty", func() {
+			q := coll.NewPriorityQueue[int](intAsc)
+
+			_, ok := q.Peek()
+
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey(".Clear", t, func() {
+		Convey("Should remove all elements", func() {
+			q := coll.NewPriorityQueue[int](intAsc)
+
+			q.Push(1)
+			q.Push(2)
+			q.Clear()
+
+			So(q.Size(), ShouldEqual, 0)
+			So(q.Empty(), ShouldBeTrue)
+		})
+	})
+
+	Convey(".Values", t, func() {
+		Convey("Should return all elements", func() {
+			q := coll.NewPriorityQueue[int](intAsc)
+
+			q.Push(1)
+			q.Push(2)
+			q.Push(3)
+
+			So(q.Values(), ShouldHaveLength, 3)
+		})
+	})
+
+	Convey(".String", t, func() {
+		Convey("Should render elements in heap order", func() {
+			q := coll.NewPriorityQueue[int](intAsc)
+
+			q.Push(3)
+			q.Push(1)
+
+			So(q.String(), ShouldEqual, "[1,3]")
+		})
+	})
+}
@@ -0,0 +1,95 @@
+package coll
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Set is a generic unordered collection of unique, comparable elements.
+type Set[T comparable] struct {
+	data map[T]struct{}
+}
+
+// NewSet creates an empty Set with the given initial capacity.
+func NewSet[T comparable](cap int) *Set[T] {
+	return &Set[T]{data: make(map[T]struct{}, cap)}
+}
+
+// NewSetWith creates a Set populated with the given elements.
+func NewSetWith[T comparable](items ...T) *Set[T] {
+	s := NewSet[T](len(items))
+
+	for _, item := range items {
+		s.Add(item)
+	}
+
+	return s
+}
+
+func (s *Set[T]) Empty() bool {
+	return len(s.data) == 0
+}
+
+func (s *Set[T]) Size() int {
+	return len(s.data)
+}
+
+func (s *Set[T]) Clear() {
+	s.data = make(map[T]struct{})
+}
+
+func (s *Set[T]) Values() []T {
+	out := make([]T, 0, len(s.data))
+
+	for item := range s.data {
+		out = append(out, item)
+	}
+
+	return out
+}
+
+// Add inserts value into the set. It returns false when the value was
+// already present.
+func (s *Set[T]) Add(value T) bool {
+	if _, found := s.data[value]; found {
+		return false
+	}
+
+	s.data[value] = struct{}{}
+
+	return true
+}
+
+// Contains reports whether value is present in the set.
+func (s *Set[T]) Contains(value T) bool {
+	_, found := s.data[value]
+
+	return found
+}
+
+// Remove deletes value from the set, if present.
+func (s *Set[T]) Remove(value T) {
+	delete(s.data, value)
+}
+
+func (s *Set[T]) String() string {
+	var b strings.Builder
+
+	b.WriteByte('{')
+
+	i := 0
+
+	for item := range s.data {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		b.WriteString(fmt.Sprintf("%v", item))
+
+		i++
+	}
+
+	b.WriteByte('}')
+
+	return b.String()
+}
@@ -0,0 +1,65 @@
+package coll_test
+
+import (
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values/coll"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSet(t *testing.T) {
+	Convey("#constructor", t, func() {
+		Convey("Should create an empty set", func() {
+			s := coll.NewSet[int](10)
+
+			So(s.Size(), ShouldEqual, 0)
+		})
+
+		Convey("Should create a set from passed values, de-duplicating them", func() {
+			s := coll.NewSetWith(1, 2, 2, 3)
+
+			So(s.Size(), ShouldEqual, 3)
+		})
+	})
+
+	Convey(".Add", t, func() {
+		Convey("Should add a new value", func() {
+			s := coll.NewSet[int](10)
+
+			So(s.Add(1), ShouldBeTrue)
+			So(s.Size(), ShouldEqual, 1)
+		})
+
+		Convey("Should return false for a duplicate value", func() {
+			s := coll.NewSetWith(1)
+
+			So(s.Add(1), ShouldBeFalse)
+			So(s.Size(), ShouldEqual, 1)
+		})
+	})
+
+	Convey(".Contains", t, func() {
+		Convey("Should return true when present", func() {
+			s := coll.NewSetWith(1, 2)
+
+			So(s.Contains(1), ShouldBeTrue)
+		})
+
+		Convey("Should return false when absent", func() {
+			s := coll.NewSetWith(1, 2)
+
+			So(s.Contains(3), ShouldBeFalse)
+		})
+	})
+
+	Convey(".Remove", t, func() {
+		Convey("Should remove a value", func() {
+			s := coll.NewSetWith(1, 2)
+
+			s.Remove(1)
+
+			So(s.Contains(1), ShouldBeFalse)
+			So(s.Size(), ShouldEqual, 1)
+		})
+	})
+}
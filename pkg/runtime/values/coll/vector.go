@@ -0,0 +1,158 @@
+package coll
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Vector is a generic, growable sequence of elements backed by a plain Go
+// slice. It is the storage backend for values.Array.
+type Vector[T any] struct {
+	items []T
+}
+
+// NewVector creates an empty Vector with the given initial capacity.
+func NewVector[T any](cap int) *Vector[T] {
+	return &Vector[T]{items: make([]T, 0, cap)}
+}
+
+// NewVectorWith creates a Vector populated with the given elements.
+func NewVectorWith[T any](items ...T) *Vector[T] {
+	v := &Vector[T]{items: make([]T, len(items))}
+
+	copy(v.items, items)
+
+	return v
+}
+
+func (v *Vector[T]) Empty() bool {
+	return len(v.items) == 0
+}
+
+func (v *Vector[T]) Size() int {
+	return len(v.items)
+}
+
+func (v *Vector[T]) Clear() {
+	v.items = v.items[:0]
+}
+
+func (v *Vector[T]) Values() []T {
+	out := make([]T, len(v.items))
+
+	copy(out, v.items)
+
+	return out
+}
+
+func (v *Vector[T]) String() string {
+	var b strings.Builder
+
+	b.WriteByte('[')
+
+	for i, item := range v.items {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		b.WriteString(fmt.Sprintf("%v", item))
+	}
+
+	b.WriteByte(']')
+
+	return b.String()
+}
+
+// Get returns the element at idx, or the zero value of T when idx is out
+// of bounds.
+func (v *Vector[T]) Get(idx int) (T, bool) {
+	if idx < 0 || idx >= len(v.items) {
+		var zero T
+
+		return zero, false
+	}
+
+	return v.items[idx], true
+}
+
+// Set replaces the element at idx. It returns false when idx is out of
+// bounds.
+func (v *Vector[T]) Set(idx int, value T) bool {
+	if idx < 0 || idx >= len(v.items) {
+		return false
+	}
+
+	v.items[idx] = value
+
+	return true
+}
+
+// Push appends value to the end of the vector.
+func (v *Vector[T]) Push(value T) {
+	v.items = append(v.items, value)
+}
+
+// Insert places value at idx, shifting subsequent elements to the right.
+// When idx is out of bounds, it is clamped to the nearest valid position.
+func (v *Vector[T]) Insert(idx int, value T) {
+	if idx < 0 {
+		idx = 0
+	}
+
+	if idx >= len(v.items) {
+		v.items = append(v.items, value)
+
+		return
+	}
+
+	v.items = append(v.items, value)
+	copy(v.items[idx+1:], v.items[idx:])
+	v.items[idx] = value
+}
+
+// RemoveAt removes the element at idx, if any.
+func (v *Vector[T]) RemoveAt(idx int) {
+	if idx < 0 || idx >= len(v.items) {
+		return
+	}
+
+	v.items = append(v.items[:idx], v.items[idx+1:]...)
+}
+
+// Slice returns a sub-slice of the vector's elements in the range [from, to).
+func (v *Vector[T]) Slice(from, to int) []T {
+	if from < 0 {
+		from = 0
+	}
+
+	if to > len(v.items) {
+		to = len(v.items)
+	}
+
+	if from >= to {
+		return []T{}
+	}
+
+	out := make([]T, to-from)
+	copy(out, v.items[from:to])
+
+	return out
+}
+
+// ForEach iterates over the vector, stopping when the callback returns
+// false.
+func (v *Vector[T]) ForEach(predicate func(value T, idx int) bool) {
+	for idx, item := range v.items {
+		if !predicate(item, idx) {
+			break
+		}
+	}
+}
+
+// Clone returns a shallow copy of the vector.
+func (v *Vector[T]) Clone() *Vector[T] {
+	out := make([]T, len(v.items))
+	copy(out, v.items)
+
+	return &Vector[T]{items: out}
+}
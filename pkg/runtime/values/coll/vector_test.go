@@ -0,0 +1,149 @@
+package coll_test
+
+import (
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values/coll"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestVector(t *testing.T) {
+	Convey("#constructor", t, func() {
+		Convey("Should create an empty vector", func() {
+			v := coll.NewVector[int](10)
+
+			So(v.Size(), ShouldEqual, 0)
+			So(v.Empty(), ShouldBeTrue)
+		})
+
+		Convey("Should create a vector from passed values", func() {
+			v := coll.NewVectorWith(1, 2, 3)
+
+			So(v.Size(), ShouldEqual, 3)
+		})
+	})
+
+	Convey(".Get", t, func() {
+		Convey("Should return item by index", func() {
+			v := coll.NewVectorWith(1, 2, 3)
+
+			val, found := v.Get(1)
+
+			So(found, ShouldBeTrue)
+			So(val, ShouldEqual, 2)
+		})
+
+		Convey("Should return false when out of bounds", func() {
+			v := coll.NewVector[int](0)
+
+			_, found := v.Get(0)
+
+			So(found, ShouldBeFalse)
+		})
+	})
+
+	Convey(".Set", t, func() {
+		Convey("Should set item by index", func() {
+			v := coll.NewVectorWith(0)
+
+			ok := v.Set(0, 1)
+
+			So(ok, ShouldBeTrue)
+			val, _ := v.Get(0)
+			So(val, ShouldEqual, 1)
+		})
+
+		Convey("Should return false when out of bounds", func() {
+			v := coll.NewVector[int](10)
+
+			So(v.Set(0, 1), ShouldBeFalse)
+		})
+	})
+
+	Convey(".Push", t, func() {
+		Convey("Should add an item", func() {
+			v := coll.NewVector[int](10)
+
+			v.Push(1)
+			v.Push(2)
+
+			So(v.Size(), ShouldEqual, 2)
+		})
+	})
+
+	Convey(".Insert", t, func() {
+		Convey("Should insert an item in the middle", func() {
+			v := coll.NewVectorWith(0, 1, 2, 3, 4, 5)
+
+			v.Insert(3, 100)
+
+			val, _ := v.Get(3)
+
+			So(v.Size(), ShouldEqual, 7)
+			So(val, ShouldEqual, 100)
+		})
+	})
+
+	Convey(".RemoveAt", t, func() {
+		Convey("Should remove an item", func() {
+			v := coll.NewVectorWith(0, 1, 2, 3)
+
+			v.RemoveAt(1)
+
+			val, _ := v.Get(1)
+
+			So(v.Size(), ShouldEqual, 3)
+			So(val, ShouldEqual, 2)
+		})
+	})
+
+	Convey(".Slice", t, func() {
+		Convey("Should return a sub-slice", func() {
+			v := coll.NewVectorWith(0, 1, 2, 3, 4, 5)
+
+			s := v.Slice(2, 4)
+
+			So(s, ShouldResemble, []int{2, 3})
+		})
+	})
+
+	Convey(".ForEach", t, func() {
+		Convey("Should iterate over elements", func() {
+			v := coll.NewVectorWith(1, 2, 3)
+			counter := 0
+
+			v.ForEach(func(value int, idx int) bool {
+				counter++
+
+				return true
+			})
+
+			So(counter, ShouldEqual, v.Size())
+		})
+
+		Convey("Should break iteration when false returned", func() {
+			v := coll.NewVectorWith(1, 2, 3, 4, 5)
+			counter := 0
+
+			v.ForEach(func(value int, idx int) bool {
+				counter++
+
+				return value < 3
+			})
+
+			So(counter, ShouldEqual, 3)
+		})
+	})
+
+	Convey(".Clone", t, func() {
+		Convey("Should return an independent copy", func() {
+			v := coll.NewVectorWith(1, 2, 3)
+			clone := v.Clone()
+
+			clone.Push(4)
+
+			So(v.Size(), ShouldEqual, 3)
+			So(clone.Size(), ShouldEqual, 4)
+		})
+	})
+}
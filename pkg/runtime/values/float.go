@@ -0,0 +1,93 @@
+package values
+
+import (
+	"strconv"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+type Float float64
+
+const ZeroFloat = Float(0)
+
+func NewFloat(val float64) Float {
+	return Float(val)
+}
+
+func (t Float) MarshalJSON() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t Float) Type() core.Type {
+	return core.FloatType
+}
+
+func (t Float) String() string {
+	return strconv.FormatFloat(float64(t), 'f', -1, 64)
+}
+
+func (t Float) Compare(other core.Value) int64 {
+	switch other.Type() {
+	case core.FloatType:
+		otherVal := other.(Float)
+
+		if t == otherVal {
+			return 0
+		} else if t < otherVal {
+			return -1
+		}
+
+		return 1
+	case core.IntType:
+		otherVal := Float(other.(Int))
+
+		if t == otherVal {
+			return 0
+		} else if t < otherVal {
+			return -1
+		}
+
+		return 1
+	default:
+		return compareByType(t, other)
+	}
+}
+
+func (t Float) Unwrap() interface{} {
+	return float64(t)
+}
+
+func (t Float) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(float64(t))
+}
+
+func (t *Float) UnmarshalCBOR(data []byte) error {
+	var val float64
+
+	if err := cbor.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*t = Float(val)
+
+	return nil
+}
+
+func (t Float) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(float64(t))
+}
+
+func (t *Float) UnmarshalMsgpack(data []byte) error {
+	var val float64
+
+	if err := msgpack.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*t = Float(val)
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package values
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// ErrCyclicReference is returned internally when DeepEqual or Hash walk
+// into a value that references itself.
+var ErrCyclicReference = errors.New("cyclic reference detected")
+
+// Hash returns a stable, process-independent hash of v. Arrays and Objects
+// are hashed recursively over their elements (Objects in key-sorted,
+// canonical order), so structurally equal values always hash the same. A
+// cyclic reference is treated as a hash of 0 rather than recursing forever.
+func Hash(v core.Value) uint64 {
+	h, err := hashChecked(v, make(map[interface{}]bool))
+
+	if err != nil {
+		return 0
+	}
+
+	return h
+}
+
+func hashChecked(v core.Value, visited map[interface{}]bool) (uint64, error) {
+	switch val := v.(type) {
+	case *Array:
+		if visited[val] {
+			return 0, ErrCyclicReference
+		}
+
+		visited[val] = true
+		defer delete(visited, val)
+
+		h := fnv.New64a()
+		h.Write([]byte{byte(core.ArrayType)})
+
+		for i := 0; i < val.Length(); i++ {
+			elemHash, err := hashChecked(val.Get(i), visited)
+
+			if err != nil {
+				return 0, err
+			}
+
+			writeUint64(h, elemHash)
+		}
+
+		return h.Sum64(), nil
+	case *Object:
+		if visited[val] {
+			return 0, ErrCyclicReference
+		}
+
+		visited[val] = true
+		defer delete(visited, val)
+
+		keys := val.Keys()
+		sort.Strings(keys)
+
+		h := fnv.New64a()
+		h.Write([]byte{byte(core.ObjectType)})
+
+		for _, key := range keys {
+			elemVal, _ := val.Get(key)
+
+			elemHash, err := hashChecked(elemVal, visited)
+
+			if err != nil {
+				return 0, err
+			}
+
+			h.Write([]byte(key))
+			writeUint64(h, elemHash)
+		}
+
+		return h.Sum64(), nil
+	case *Set:
+		// Set.Values() iterates buckets in Go's randomized map order. Hash
+		// each element individually and sort the resulting hashes before
+		// combining them, so the result doesn't depend on iteration order.
+		if visited[val] {
+			return 0, ErrCyclicReference
+		}
+
+		visited[val] = true
+		defer delete(visited, val)
+
+		elems := val.Values()
+		elemHashes := make([]uint64, 0, len(elems))
+
+		for _, elem := range elems {
+			elemHash, err := hashChecked(elem, visited)
+
+			if err != nil {
+				return 0, err
+			}
+
+			elemHashes = append(elemHashes, elemHash)
+		}
+
+		sort.Slice(elemHashes, func(i, j int) bool { return elemHashes[i] < elemHashes[j] })
+
+		h := fnv.New64a()
+		h.Write([]byte{byte(core.SetType)})
+
+		for _, elemHash := range elemHashes {
+			writeUint64(h, elemHash)
+		}
+
+		return h.Sum64(), nil
+	default:
+		h := fnv.New64a()
+		h.Write([]byte{byte(v.Type())})
+		h.Write([]byte(v.String()))
+
+		return h.Sum64(), nil
+	}
+}
+
+func writeUint64(h io.Writer, v uint64) {
+	var buf [8]byte
+
+	binary.BigEndian.PutUint64(buf[:], v)
+	h.Write(buf[:])
+}
+
+// DeepEqual reports whether a and b are structurally equal: Arrays are
+// compared element-wise in order, Objects are compared key-by-key
+// regardless of insertion order, and scalars use Compare. NaN floats are
+// considered equal to one another. A cyclic reference is treated as
+// non-equal rather than recursing forever.
+func DeepEqual(a, b core.Value) bool {
+	eq, err := deepEqualChecked(a, b, make(map[interface{}]bool))
+
+	if err != nil {
+		return false
+	}
+
+	return eq
+}
+
+func deepEqualChecked(a, b core.Value, visited map[interface{}]bool) (bool, error) {
+	if a.Type() != b.Type() {
+		return false, nil
+	}
+
+	switch av := a.(type) {
+	case *Array:
+		bv := b.(*Array)
+
+		if visited[av] {
+			return false, ErrCyclicReference
+		}
+
+		visited[av] = true
+		defer delete(visited, av)
+
+		if av.Length() != bv.Length() {
+			return false, nil
+		}
+
+		for i := 0; i < av.Length(); i++ {
+			eq, err := deepEqualChecked(av.Get(i), bv.Get(i), visited)
+
+			if err != nil {
+				return false, err
+			}
+
+			if !eq {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	case *Object:
+		bv := b.(*Object)
+
+		if visited[av] {
+			return false, ErrCyclicReference
+		}
+
+		visited[av] = true
+		defer delete(visited, av)
+
+		if av.Length() != bv.Length() {
+			return false, nil
+		}
+
+		for _, key := range av.Keys() {
+			aVal, _ := av.Get(key)
+			bVal, found := bv.Get(key)
+
+			if !found {
+				return false, nil
+			}
+
+			eq, err := deepEqualChecked(aVal, bVal, visited)
+
+			if err != nil {
+				return false, err
+			}
+
+			if !eq {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	case Float:
+		bv := b.(Float)
+
+		if math.IsNaN(float64(av)) && math.IsNaN(float64(bv)) {
+			return true, nil
+		}
+
+		return av.Compare(bv) == 0, nil
+	default:
+		return a.Compare(b) == 0, nil
+	}
+}
@@ -0,0 +1,87 @@
+package values_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDeepEqual(t *testing.T) {
+	Convey(".DeepEqual", t, func() {
+		Convey("Should return true for equal scalars", func() {
+			So(values.DeepEqual(values.NewInt(1), values.NewInt(1)), ShouldBeTrue)
+		})
+
+		Convey("Should return false for different types", func() {
+			So(values.DeepEqual(values.NewInt(1), values.NewString("1")), ShouldBeFalse)
+		})
+
+		Convey("Should treat NaN as equal to NaN", func() {
+			nan := values.NewFloat(math.NaN())
+
+			So(values.DeepEqual(nan, nan), ShouldBeTrue)
+		})
+
+		Convey("Should compare arrays element-wise", func() {
+			a := values.NewArrayWith(values.NewInt(1), values.NewInt(2))
+			b := values.NewArrayWith(values.NewInt(1), values.NewInt(2))
+			c := values.NewArrayWith(values.NewInt(2), values.NewInt(1))
+
+			So(values.DeepEqual(a, b), ShouldBeTrue)
+			So(values.DeepEqual(a, c), ShouldBeFalse)
+		})
+
+		Convey("Should compare objects regardless of key order", func() {
+			a := values.NewObject()
+			a.Set("x", values.NewInt(1))
+			a.Set("y", values.NewInt(2))
+
+			b := values.NewObject()
+			b.Set("y", values.NewInt(2))
+			b.Set("x", values.NewInt(1))
+
+			So(values.DeepEqual(a, b), ShouldBeTrue)
+		})
+
+		Convey("Should return false rather than recurse forever on a cyclic array", func() {
+			a := values.NewArrayWith(values.ZeroInt)
+			a.Set(0, a)
+
+			b := values.NewArrayWith(values.ZeroInt)
+			b.Set(0, b)
+
+			So(values.DeepEqual(a, b), ShouldBeFalse)
+		})
+	})
+
+	Convey(".Hash", t, func() {
+		Convey("Should be stable across calls", func() {
+			arr := values.NewArrayWith(values.NewInt(1), values.NewInt(2))
+
+			So(values.Hash(arr), ShouldEqual, values.Hash(arr))
+		})
+
+		Convey("Should match for structurally equal values", func() {
+			a := values.NewArrayWith(values.NewInt(1), values.NewInt(2))
+			b := values.NewArrayWith(values.NewInt(1), values.NewInt(2))
+
+			So(values.Hash(a), ShouldEqual, values.Hash(b))
+		})
+
+		Convey("Should differ for different values", func() {
+			a := values.NewArrayWith(values.NewInt(1))
+			b := values.NewArrayWith(values.NewInt(2))
+
+			So(values.Hash(a), ShouldNotEqual, values.Hash(b))
+		})
+
+		Convey("Should not recurse forever on a cyclic array", func() {
+			a := values.NewArrayWith(values.ZeroInt)
+			a.Set(0, a)
+
+			So(values.Hash(a), ShouldEqual, uint64(0))
+		})
+	})
+}
@@ -0,0 +1,94 @@
+package values
+
+import (
+	"strconv"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+type Int int
+
+const ZeroInt = Int(0)
+
+func NewInt(val int) Int {
+	return Int(val)
+}
+
+func (t Int) MarshalJSON() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t Int) Type() core.Type {
+	return core.IntType
+}
+
+func (t Int) String() string {
+	return strconv.Itoa(int(t))
+}
+
+func (t Int) Compare(other core.Value) int64 {
+	switch other.Type() {
+	case core.IntType:
+		otherVal := other.(Int)
+
+		if t == otherVal {
+			return 0
+		} else if t < otherVal {
+			return -1
+		}
+
+		return 1
+	case core.FloatType:
+		otherVal := other.(Float)
+		thisVal := Float(t)
+
+		if thisVal == otherVal {
+			return 0
+		} else if thisVal < otherVal {
+			return -1
+		}
+
+		return 1
+	default:
+		return compareByType(t, other)
+	}
+}
+
+func (t Int) Unwrap() interface{} {
+	return int(t)
+}
+
+func (t Int) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(int(t))
+}
+
+func (t *Int) UnmarshalCBOR(data []byte) error {
+	var val int
+
+	if err := cbor.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*t = Int(val)
+
+	return nil
+}
+
+func (t Int) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(int(t))
+}
+
+func (t *Int) UnmarshalMsgpack(data []byte) error {
+	var val int
+
+	if err := msgpack.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*t = Int(val)
+
+	return nil
+}
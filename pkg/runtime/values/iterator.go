@@ -0,0 +1,61 @@
+package values
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// arrayIterator walks an Array's underlying Vector directly, so Array.ForEach
+// (and anything a FOR loop eventually drives through it) reads typed
+// elements straight from the container instead of reboxing them through
+// Get/Unwrap on every step.
+type arrayIterator struct {
+	arr *Array
+	pos int
+}
+
+// NewArrayIterator creates a core.Iterator over arr.
+func NewArrayIterator(arr *Array) core.Iterator {
+	return &arrayIterator{arr: arr}
+}
+
+func (iter *arrayIterator) Next(_ context.Context) (core.Value, core.Value, error) {
+	if iter.pos >= iter.arr.Length() {
+		return nil, nil, core.ErrNoMoreData
+	}
+
+	value, _ := iter.arr.vector.Get(iter.pos)
+	key := NewInt(iter.pos)
+
+	iter.pos++
+
+	return value, key, nil
+}
+
+// objectIterator walks an Object's underlying Map directly, yielding
+// key/value pairs in insertion order. Object.ForEach drives it the same way
+// arrayIterator backs Array.ForEach.
+type objectIterator struct {
+	obj  *Object
+	keys []string
+	pos  int
+}
+
+// NewObjectIterator creates a core.Iterator over obj.
+func NewObjectIterator(obj *Object) core.Iterator {
+	return &objectIterator{obj: obj, keys: obj.Keys()}
+}
+
+func (iter *objectIterator) Next(_ context.Context) (core.Value, core.Value, error) {
+	if iter.pos >= len(iter.keys) {
+		return nil, nil, core.ErrNoMoreData
+	}
+
+	key := iter.keys[iter.pos]
+	value, _ := iter.obj.Get(key)
+
+	iter.pos++
+
+	return value, NewString(key), nil
+}
@@ -0,0 +1,58 @@
+package values
+
+import (
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// NoneType represents the absence of a value.
+type NoneType struct{}
+
+// None is the singleton instance representing the absence of a value.
+var None = NoneType{}
+
+func (t NoneType) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+func (t NoneType) Type() core.Type {
+	return core.NoneType
+}
+
+func (t NoneType) String() string {
+	return "None"
+}
+
+func (t NoneType) Compare(other core.Value) int64 {
+	if other.Type() == core.NoneType {
+		return 0
+	}
+
+	return -1
+}
+
+func (t NoneType) Unwrap() interface{} {
+	return nil
+}
+
+func (t NoneType) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(nil)
+}
+
+func (t *NoneType) UnmarshalCBOR(data []byte) error {
+	var val interface{}
+
+	return cbor.Unmarshal(data, &val)
+}
+
+func (t NoneType) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(nil)
+}
+
+func (t *NoneType) UnmarshalMsgpack(data []byte) error {
+	var val interface{}
+
+	return msgpack.Unmarshal(data, &val)
+}
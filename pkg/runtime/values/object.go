@@ -0,0 +1,218 @@
+package values
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values/coll"
+)
+
+// Object represents the FQL Object value. It is a thin wrapper over a
+// coll.Map[string, core.Value], kept around mainly for its JSON/Compare/
+// String semantics - the generic container does the actual storage work.
+type Object struct {
+	m *coll.Map[string, core.Value]
+}
+
+// NewObject creates an empty object.
+func NewObject() *Object {
+	return &Object{m: coll.NewMap[string, core.Value](10)}
+}
+
+// Raw exposes the underlying generic container so extension packages can
+// build iterators and transforms over the object without paying the cost of
+// repeated Unwrap() boxing.
+func (t *Object) Raw() *coll.Map[string, core.Value] {
+	return t.m
+}
+
+func (t *Object) MarshalJSON() ([]byte, error) {
+	var b strings.Builder
+
+	b.WriteByte('{')
+
+	i := 0
+
+	t.ForEach(func(value core.Value, key string) bool {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		keyBytes, err := String(key).MarshalJSON()
+
+		if err == nil {
+			b.Write(keyBytes)
+		}
+
+		b.WriteByte(':')
+
+		bytes, err := value.MarshalJSON()
+
+		if err == nil {
+			b.Write(bytes)
+		}
+
+		i++
+
+		return true
+	})
+
+	b.WriteByte('}')
+
+	return []byte(b.String()), nil
+}
+
+func (t *Object) Type() core.Type {
+	return core.ObjectType
+}
+
+func (t *Object) String() string {
+	return t.m.String()
+}
+
+func (t *Object) Compare(other core.Value) int64 {
+	switch other.Type() {
+	case core.ObjectType:
+		otherObj := other.(*Object)
+
+		thisLen := t.Length()
+		otherLen := otherObj.Length()
+
+		if thisLen == otherLen {
+			keys := t.Keys()
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				otherVal, found := otherObj.Get(key)
+
+				if !found {
+					return 1
+				}
+
+				thisVal, _ := t.Get(key)
+
+				if cmp := thisVal.Compare(otherVal); cmp != 0 {
+					return cmp
+				}
+			}
+
+			return 0
+		} else if thisLen < otherLen {
+			return -1
+		}
+
+		return 1
+	case core.ArrayType:
+		return 1
+	default:
+		return compareByType(t, other)
+	}
+}
+
+func (t *Object) Unwrap() interface{} {
+	out := make(map[string]interface{})
+
+	t.ForEach(func(value core.Value, key string) bool {
+		out[key] = value.Unwrap()
+
+		return true
+	})
+
+	return out
+}
+
+// Length returns the number of keys in the object.
+func (t *Object) Length() int {
+	return t.m.Size()
+}
+
+// Keys returns the object's keys in insertion order.
+func (t *Object) Keys() []string {
+	return t.m.Keys()
+}
+
+// Get returns the value stored under key, if present.
+func (t *Object) Get(key string) (core.Value, bool) {
+	return t.m.Get(key)
+}
+
+// Set stores value under key.
+func (t *Object) Set(key string, value core.Value) {
+	t.m.Set(key, value)
+}
+
+// Remove deletes key from the object, if present.
+func (t *Object) Remove(key string) {
+	t.m.Remove(key)
+}
+
+// Has reports whether key exists in the object.
+func (t *Object) Has(key string) bool {
+	return t.m.Has(key)
+}
+
+// ForEach iterates over the object in insertion order, stopping when the
+// callback returns false. It drives NewObjectIterator rather than t.m.ForEach
+// directly, so every Object consumer (MarshalJSON, Unwrap) goes through the
+// same core.Iterator protocol a FOR loop would.
+func (t *Object) ForEach(predicate func(value core.Value, key string) bool) {
+	iter := NewObjectIterator(t)
+	ctx := context.Background()
+
+	for {
+		value, key, err := iter.Next(ctx)
+
+		if err != nil {
+			return
+		}
+
+		if !predicate(value, string(key.(String))) {
+			return
+		}
+	}
+}
+
+func (t *Object) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(t.Unwrap())
+}
+
+func (t *Object) UnmarshalCBOR(data []byte) error {
+	var raw map[string]interface{}
+
+	if err := cbor.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	t.m = coll.NewMap[string, core.Value](len(raw))
+
+	for key, item := range raw {
+		t.m.Set(key, Parse(normalizeDecoded(item)))
+	}
+
+	return nil
+}
+
+func (t *Object) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(t.Unwrap())
+}
+
+func (t *Object) UnmarshalMsgpack(data []byte) error {
+	var raw map[string]interface{}
+
+	if err := msgpack.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	t.m = coll.NewMap[string, core.Value](len(raw))
+
+	for key, item := range raw {
+		t.m.Set(key, Parse(normalizeDecoded(item)))
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package values_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestObject(t *testing.T) {
+	Convey("#constructor", t, func() {
+		Convey("Should create an empty object", func() {
+			obj := values.NewObject()
+
+			So(obj.Length(), ShouldEqual, 0)
+		})
+	})
+
+	Convey(".MarshalJSON", t, func() {
+		Convey("Should serialize an empty object", func() {
+			obj := values.NewObject()
+			marshaled, err := obj.MarshalJSON()
+
+			So(err, ShouldBeNil)
+			So(string(marshaled), ShouldEqual, "{}")
+		})
+
+		Convey("Should serialize a full object, quoting keys", func() {
+			obj := values.NewObject()
+			obj.Set("a", values.NewInt(1))
+			obj.Set("b", values.NewInt(2))
+
+			marshaled, err := json.Marshal(obj)
+
+			So(err, ShouldBeNil)
+			So(string(marshaled), ShouldEqual, `{"a":1,"b":2}`)
+		})
+
+		Convey("Should produce output that round-trips through encoding/json", func() {
+			obj := values.NewObject()
+			obj.Set("x", values.NewInt(1))
+
+			marshaled, err := obj.MarshalJSON()
+			So(err, ShouldBeNil)
+
+			var out map[string]interface{}
+			err = json.Unmarshal(marshaled, &out)
+
+			So(err, ShouldBeNil)
+			So(out, ShouldResemble, map[string]interface{}{"x": float64(1)})
+		})
+	})
+
+	Convey(".Type", t, func() {
+		Convey("Should return type", func() {
+			obj := values.NewObject()
+
+			So(obj.Type(), ShouldEqual, core.ObjectType)
+		})
+	})
+
+	Convey(".Set/.Get", t, func() {
+		Convey("Should store and retrieve a value", func() {
+			obj := values.NewObject()
+			obj.Set("a", values.NewInt(1))
+
+			val, found := obj.Get("a")
+
+			So(found, ShouldBeTrue)
+			So(val.Compare(values.NewInt(1)), ShouldEqual, 0)
+		})
+
+		Convey("Should return false for a missing key", func() {
+			obj := values.NewObject()
+
+			_, found := obj.Get("missing")
+
+			So(found, ShouldBeFalse)
+		})
+	})
+
+	Convey(".Remove", t, func() {
+		Convey("Should remove a key", func() {
+			obj := values.NewObject()
+			obj.Set("a", values.NewInt(1))
+			obj.Remove("a")
+
+			So(obj.Has("a"), ShouldBeFalse)
+			So(obj.Length(), ShouldEqual, 0)
+		})
+	})
+
+	Convey(".Compare", t, func() {
+		Convey("It should return 1 for all non-object values", func() {
+			obj := values.NewObject()
+
+			So(obj.Compare(values.None), ShouldEqual, 1)
+			So(obj.Compare(values.ZeroInt), ShouldEqual, 1)
+		})
+
+		Convey("It should return 1 for array values", func() {
+			obj := values.NewObject()
+			arr := values.NewArrayWith(values.ZeroInt)
+
+			So(obj.Compare(arr), ShouldEqual, 1)
+		})
+
+		Convey("It should return 0 when both objects are empty", func() {
+			obj1 := values.NewObject()
+			obj2 := values.NewObject()
+
+			So(obj1.Compare(obj2), ShouldEqual, 0)
+		})
+
+		Convey("It should return 0 for objects with the same keys/values regardless of order", func() {
+			obj1 := values.NewObject()
+			obj1.Set("a", values.NewInt(1))
+			obj1.Set("b", values.NewInt(2))
+
+			obj2 := values.NewObject()
+			obj2.Set("b", values.NewInt(2))
+			obj2.Set("a", values.NewInt(1))
+
+			So(obj1.Compare(obj2), ShouldEqual, 0)
+		})
+
+		Convey("It should be antisymmetric against a Set", func() {
+			obj := values.NewObject()
+			set := values.NewSetWith(values.ZeroInt)
+
+			So(obj.Compare(set), ShouldEqual, -1)
+			So(set.Compare(obj), ShouldEqual, 1)
+		})
+	})
+}
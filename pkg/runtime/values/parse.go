@@ -0,0 +1,65 @@
+package values
+
+import "github.com/MontFerret/ferret/pkg/runtime/core"
+
+// Parse converts a plain Go value - typically the output of
+// encoding/json.Unmarshal into interface{} - into the equivalent core.Value.
+// It is mainly used by backends that round-trip values through a generic
+// encoding (JSON spill files, channels carrying decoded payloads, etc).
+//
+// All integer widths are handled explicitly rather than just int/int64:
+// msgpack, in particular, picks the narrowest Go type that fits the wire
+// value (e.g. int8 for small integers), so a decoder that only recognized
+// int/int64/uint64 would silently drop those values to None.
+func Parse(v interface{}) core.Value {
+	switch val := v.(type) {
+	case nil:
+		return None
+	case bool:
+		return NewBoolean(val)
+	case float64:
+		return NewFloat(val)
+	case int:
+		return NewInt(val)
+	case int8:
+		return NewInt(int(val))
+	case int16:
+		return NewInt(int(val))
+	case int32:
+		return NewInt(int(val))
+	case int64:
+		return NewInt(int(val))
+	case uint:
+		return NewInt(int(val))
+	case uint8:
+		return NewInt(int(val))
+	case uint16:
+		return NewInt(int(val))
+	case uint32:
+		return NewInt(int(val))
+	case uint64:
+		return NewInt(int(val))
+	case string:
+		return NewString(val)
+	case []byte:
+		return NewBinary(val)
+	case []interface{}:
+		arr := NewArray(len(val))
+
+		for _, item := range val {
+			arr.Push(Parse(item))
+		}
+
+		return arr
+	case map[string]interface{}:
+		obj := NewObject()
+
+		for key, item := range val {
+			obj.Set(key, Parse(item))
+		}
+
+		return obj
+	default:
+		return None
+	}
+}
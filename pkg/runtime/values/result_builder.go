@@ -0,0 +1,108 @@
+package values
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values/stream"
+)
+
+// ResultBuilder accumulates the values produced by a FOR/RETURN pipeline and
+// yields either an Array or a StreamArray depending on ShouldStream, so the
+// caller doesn't have to pick a representation before it knows the final
+// size. Nothing in this module constructs one yet: no FOR/RETURN code path
+// here routes its results through it.
+//
+// It buffers into a MemoryBackend. Once the pushed count crosses the
+// streaming threshold, it spills what's buffered so far (plus everything
+// pushed afterwards) into an FSBackend, so a pipeline that turns out to
+// produce millions of rows doesn't hold them all in memory just because its
+// size wasn't known up front.
+type ResultBuilder struct {
+	backend   stream.Backend
+	streaming bool
+	spillDir  string
+	count     int
+}
+
+// NewResultBuilder creates a ResultBuilder that spills to a temporary file
+// in spillDir (the OS default temp directory when empty) once streaming.
+func NewResultBuilder(spillDir string) *ResultBuilder {
+	return &ResultBuilder{backend: stream.NewMemoryBackend(0), spillDir: spillDir}
+}
+
+// Push appends value to the result, switching to a streaming backend once
+// the accumulated count exceeds the configured threshold.
+func (b *ResultBuilder) Push(value core.Value) error {
+	if err := b.backend.Push(value); err != nil {
+		return err
+	}
+
+	b.count++
+
+	if !b.streaming && ShouldStream(b.count) {
+		if err := b.startStreaming(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *ResultBuilder) startStreaming() error {
+	fsBackend, err := stream.NewFSBackend(b.spillDir, stream.DecodeJSON(Parse))
+
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	iter := b.backend.Iterate()
+
+	for {
+		value, _, err := iter.Next(ctx)
+
+		if err != nil {
+			break
+		}
+
+		if err := fsBackend.Push(value); err != nil {
+			return err
+		}
+	}
+
+	if err := b.backend.Close(); err != nil {
+		return err
+	}
+
+	b.backend = fsBackend
+	b.streaming = true
+
+	return nil
+}
+
+// Build returns the accumulated result: an *Array when the pushed count
+// never exceeded the streaming threshold, or a *StreamArray reading from the
+// spilled backend otherwise.
+func (b *ResultBuilder) Build() core.Value {
+	if !b.streaming {
+		n, _ := b.backend.Len()
+		out := make([]core.Value, 0, n)
+		ctx := context.Background()
+		iter := b.backend.Iterate()
+
+		for {
+			value, _, err := iter.Next(ctx)
+
+			if err != nil {
+				break
+			}
+
+			out = append(out, value)
+		}
+
+		return NewArrayWith(out...)
+	}
+
+	return NewStreamArray(b.backend)
+}
@@ -0,0 +1,48 @@
+package values_test
+
+import (
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestResultBuilder(t *testing.T) {
+	Convey("Should build a plain Array when the count stays under the threshold", t, func() {
+		values.SetStreamThreshold(10)
+		defer values.SetStreamThreshold(values.DefaultStreamThreshold)
+
+		b := values.NewResultBuilder("")
+
+		for i := 0; i < 3; i++ {
+			So(b.Push(values.NewInt(i)), ShouldBeNil)
+		}
+
+		result := b.Build()
+
+		_, isStreamArray := result.(*values.StreamArray)
+		So(isStreamArray, ShouldBeFalse)
+		So(result.(*values.Array).Length(), ShouldEqual, 3)
+	})
+
+	Convey("Should switch to a StreamArray once the threshold is exceeded", t, func() {
+		values.SetStreamThreshold(3)
+		defer values.SetStreamThreshold(values.DefaultStreamThreshold)
+
+		b := values.NewResultBuilder("")
+
+		for i := 0; i < 10; i++ {
+			So(b.Push(values.NewInt(i)), ShouldBeNil)
+		}
+
+		result := b.Build()
+
+		streamed, isStreamArray := result.(*values.StreamArray)
+		So(isStreamArray, ShouldBeTrue)
+		So(streamed.Length(), ShouldEqual, 10)
+
+		for i := 0; i < 10; i++ {
+			So(streamed.Get(i).Compare(values.NewInt(i)), ShouldEqual, 0)
+		}
+	})
+}
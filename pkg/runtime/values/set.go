@@ -0,0 +1,156 @@
+package values
+
+import (
+	"sort"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// Set is a runtime value holding a collection of structurally unique
+// values. It buckets elements by values.Hash and falls back to DeepEqual
+// within a bucket, giving O(1) average-case Contains/Add/Remove instead of
+// the O(n) linear scan an Array would need.
+type Set struct {
+	buckets map[uint64][]core.Value
+	size    int
+}
+
+// NewSet creates an empty set with the given initial bucket capacity.
+func NewSet(size int) *Set {
+	return &Set{buckets: make(map[uint64][]core.Value, size)}
+}
+
+// NewSetWith creates a set populated with the given values, discarding
+// duplicates.
+func NewSetWith(values ...core.Value) *Set {
+	s := NewSet(len(values))
+
+	for _, v := range values {
+		s.Add(v)
+	}
+
+	return s
+}
+
+func (t *Set) MarshalJSON() ([]byte, error) {
+	return NewArrayWith(t.sortedValues()...).MarshalJSON()
+}
+
+func (t *Set) Type() core.Type {
+	return core.SetType
+}
+
+func (t *Set) String() string {
+	return NewArrayWith(t.sortedValues()...).String()
+}
+
+func (t *Set) Compare(other core.Value) int64 {
+	if other.Type() != core.SetType {
+		return compareByType(t, other)
+	}
+
+	return NewArrayWith(t.sortedValues()...).Compare(NewArrayWith(other.(*Set).sortedValues()...))
+}
+
+func (t *Set) Unwrap() interface{} {
+	return NewArrayWith(t.sortedValues()...).Unwrap()
+}
+
+// Length returns the number of elements in the set.
+func (t *Set) Length() int {
+	return t.size
+}
+
+// Add inserts value into the set. It returns false when an equal value was
+// already present.
+func (t *Set) Add(value core.Value) bool {
+	h := Hash(value)
+
+	for _, existing := range t.buckets[h] {
+		if DeepEqual(existing, value) {
+			return false
+		}
+	}
+
+	t.buckets[h] = append(t.buckets[h], value)
+	t.size++
+
+	return true
+}
+
+// Contains reports whether an equal value is present in the set.
+func (t *Set) Contains(value core.Value) bool {
+	h := Hash(value)
+
+	for _, existing := range t.buckets[h] {
+		if DeepEqual(existing, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Remove deletes value from the set, if present.
+func (t *Set) Remove(value core.Value) {
+	h := Hash(value)
+	bucket := t.buckets[h]
+
+	for i, existing := range bucket {
+		if DeepEqual(existing, value) {
+			t.buckets[h] = append(bucket[:i], bucket[i+1:]...)
+			t.size--
+
+			return
+		}
+	}
+}
+
+// Values returns the set's elements in unspecified order. Go's map
+// iteration is randomized per-process, so this order varies between calls -
+// methods that need a deterministic result (MarshalJSON, String, Compare,
+// Unwrap) use sortedValues instead.
+func (t *Set) Values() []core.Value {
+	out := make([]core.Value, 0, t.size)
+
+	for _, bucket := range t.buckets {
+		out = append(out, bucket...)
+	}
+
+	return out
+}
+
+// sortedValues returns the set's elements ordered by Hash, breaking ties by
+// their String representation, so that two Sets holding the same elements
+// always produce the same order regardless of map iteration order.
+func (t *Set) sortedValues() []core.Value {
+	out := t.Values()
+
+	sort.Slice(out, func(i, j int) bool {
+		hi, hj := Hash(out[i]), Hash(out[j])
+
+		if hi != hj {
+			return hi < hj
+		}
+
+		return out[i].String() < out[j].String()
+	})
+
+	return out
+}
+
+// ForEach iterates over the set in unspecified order, stopping when the
+// callback returns false.
+func (t *Set) ForEach(predicate func(value core.Value, idx int) bool) {
+	idx := 0
+
+	for _, bucket := range t.buckets {
+		for _, value := range bucket {
+			if !predicate(value, idx) {
+				return
+			}
+
+			idx++
+		}
+	}
+}
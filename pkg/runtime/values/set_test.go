@@ -0,0 +1,100 @@
+package values_test
+
+import (
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSet(t *testing.T) {
+	Convey("#constructor", t, func() {
+		Convey("Should create an empty set", func() {
+			s := values.NewSet(10)
+
+			So(s.Length(), ShouldEqual, 0)
+		})
+
+		Convey("Should create a set from passed values, de-duplicating them", func() {
+			s := values.NewSetWith(values.NewInt(1), values.NewInt(1), values.NewInt(2))
+
+			So(s.Length(), ShouldEqual, 2)
+		})
+	})
+
+	Convey(".Add/.Contains", t, func() {
+		Convey("Should add a new value", func() {
+			s := values.NewSet(10)
+
+			So(s.Add(values.NewInt(1)), ShouldBeTrue)
+			So(s.Contains(values.NewInt(1)), ShouldBeTrue)
+		})
+
+		Convey("Should return false for a structurally equal duplicate", func() {
+			s := values.NewSetWith(values.NewArrayWith(values.NewInt(1)))
+
+			So(s.Add(values.NewArrayWith(values.NewInt(1))), ShouldBeFalse)
+			So(s.Length(), ShouldEqual, 1)
+		})
+	})
+
+	Convey(".Remove", t, func() {
+		Convey("Should remove a value", func() {
+			s := values.NewSetWith(values.NewInt(1), values.NewInt(2))
+
+			s.Remove(values.NewInt(1))
+
+			So(s.Contains(values.NewInt(1)), ShouldBeFalse)
+			So(s.Length(), ShouldEqual, 1)
+		})
+	})
+
+	Convey(".Type", t, func() {
+		Convey("Should return SetType", func() {
+			s := values.NewSet(1)
+
+			So(s.Type(), ShouldEqual, core.SetType)
+		})
+	})
+
+	Convey(".String/.Compare/.Hash with several elements", t, func() {
+		elems := []core.Value{
+			values.NewInt(1), values.NewInt(2), values.NewInt(3),
+			values.NewInt(4), values.NewInt(5), values.NewInt(6),
+			values.NewInt(7), values.NewInt(8),
+		}
+
+		Convey("Should produce the same String representation across many instances", func() {
+			first := values.NewSetWith(elems...).String()
+
+			for i := 0; i < 20; i++ {
+				So(values.NewSetWith(elems...).String(), ShouldEqual, first)
+			}
+		})
+
+		Convey("Should produce the same Hash across many instances", func() {
+			first := values.Hash(values.NewSetWith(elems...))
+
+			for i := 0; i < 20; i++ {
+				So(values.Hash(values.NewSetWith(elems...)), ShouldEqual, first)
+			}
+		})
+
+		Convey("Should compare equal for two sets holding the same elements", func() {
+			a := values.NewSetWith(elems...)
+			b := values.NewSetWith(elems...)
+
+			So(a.Compare(b), ShouldEqual, 0)
+		})
+	})
+
+	Convey(".Compare against a different type", t, func() {
+		Convey("Should be antisymmetric", func() {
+			s := values.NewSetWith(values.NewInt(1))
+			i := values.NewInt(5)
+
+			So(i.Compare(s), ShouldEqual, -s.Compare(i))
+		})
+	})
+}
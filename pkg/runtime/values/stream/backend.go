@@ -0,0 +1,86 @@
+// Package stream provides pluggable storage backends for values.StreamArray
+// so large result sets can be produced and consumed without materializing
+// every element in memory at once.
+package stream
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// Backend is the storage/production strategy behind a StreamArray. Backends
+// are not required to know their size up front (e.g. a channel fed by a
+// concurrent producer), hence the ok return value on Len.
+type Backend interface {
+	// Push appends value to the backend. It returns an error for backends
+	// that do not support appends (e.g. ChannelBackend, which is fed by its
+	// producer through the channel instead).
+	Push(value core.Value) error
+
+	// Iterate returns a fresh core.Iterator over the backend's current
+	// contents.
+	Iterate() core.Iterator
+
+	// Len returns the number of produced elements and whether that count is
+	// known without fully consuming the backend.
+	Len() (n int, ok bool)
+
+	// Replayable reports whether Iterate can be called more than once and
+	// see the same contents each time. MemoryBackend and FSBackend are;
+	// ChannelBackend is not, since a channel can only be drained once.
+	Replayable() bool
+
+	// Close releases any resources held by the backend (file handles,
+	// channels, etc).
+	Close() error
+}
+
+// MemoryBackend is the default, in-memory Backend - equivalent to the
+// current, fully materialized Array behavior.
+type MemoryBackend struct {
+	items []core.Value
+}
+
+// NewMemoryBackend creates a MemoryBackend with the given initial capacity.
+func NewMemoryBackend(capacity int) *MemoryBackend {
+	return &MemoryBackend{items: make([]core.Value, 0, capacity)}
+}
+
+func (b *MemoryBackend) Push(value core.Value) error {
+	b.items = append(b.items, value)
+
+	return nil
+}
+
+func (b *MemoryBackend) Iterate() core.Iterator {
+	return &memoryIterator{items: b.items}
+}
+
+func (b *MemoryBackend) Len() (int, bool) {
+	return len(b.items), true
+}
+
+func (b *MemoryBackend) Replayable() bool {
+	return true
+}
+
+func (b *MemoryBackend) Close() error {
+	return nil
+}
+
+type memoryIterator struct {
+	items []core.Value
+	pos   int
+}
+
+func (iter *memoryIterator) Next(_ context.Context) (core.Value, core.Value, error) {
+	if iter.pos >= len(iter.items) {
+		return nil, nil, core.ErrNoMoreData
+	}
+
+	value := iter.items[iter.pos]
+	iter.pos++
+
+	return value, nil, nil
+}
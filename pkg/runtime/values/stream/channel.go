@@ -0,0 +1,62 @@
+package stream
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// ChannelBackend is fed by one or more concurrent producers writing into a
+// Go channel, rather than by explicit Push calls. Its size is unknown until
+// the producer closes the channel.
+type ChannelBackend struct {
+	values <-chan core.Value
+}
+
+// NewChannelBackend creates a ChannelBackend consuming from values. The
+// producer is responsible for closing the channel once done.
+func NewChannelBackend(values <-chan core.Value) *ChannelBackend {
+	return &ChannelBackend{values: values}
+}
+
+// Push is unsupported for a channel-fed backend; the producer writes
+// directly to the channel passed to NewChannelBackend.
+func (b *ChannelBackend) Push(core.Value) error {
+	return core.ErrInvalidType
+}
+
+func (b *ChannelBackend) Iterate() core.Iterator {
+	return &channelIterator{values: b.values}
+}
+
+// Len is never known up front for a channel-fed backend.
+func (b *ChannelBackend) Len() (int, bool) {
+	return 0, false
+}
+
+// Replayable is false: a channel can only be drained once, so a second
+// Iterate call would see nothing rather than the same contents again.
+func (b *ChannelBackend) Replayable() bool {
+	return false
+}
+
+func (b *ChannelBackend) Close() error {
+	return nil
+}
+
+type channelIterator struct {
+	values <-chan core.Value
+}
+
+func (iter *channelIterator) Next(ctx context.Context) (core.Value, core.Value, error) {
+	select {
+	case value, open := <-iter.values:
+		if !open {
+			return nil, nil, core.ErrNoMoreData
+		}
+
+		return value, nil, nil
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+}
@@ -0,0 +1,135 @@
+package stream
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// Decoder turns the raw bytes written by FSBackend back into a core.Value.
+// It is supplied by the caller (e.g. values.ResultBuilder) so this
+// package does not need to depend on the values package's concrete types.
+type Decoder func(data []byte) (core.Value, error)
+
+// FSBackend spills pushed values to a temporary file on disk using a simple
+// length-prefixed JSON encoding, so pipelines producing millions of rows
+// don't have to hold them all in memory at once.
+type FSBackend struct {
+	file   *os.File
+	decode Decoder
+	count  int
+}
+
+// NewFSBackend creates an FSBackend backed by a new temporary file in dir
+// (the OS default temp directory when dir is empty).
+func NewFSBackend(dir string, decode Decoder) (*FSBackend, error) {
+	f, err := os.CreateTemp(dir, "ferret-streamarray-*.spill")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &FSBackend{file: f, decode: decode}, nil
+}
+
+func (b *FSBackend) Push(value core.Value) error {
+	data, err := value.MarshalJSON()
+
+	if err != nil {
+		return err
+	}
+
+	var lenPrefix [4]byte
+	binary.BigEndian.PutUint32(lenPrefix[:], uint32(len(data)))
+
+	if _, err := b.file.Write(lenPrefix[:]); err != nil {
+		return err
+	}
+
+	if _, err := b.file.Write(data); err != nil {
+		return err
+	}
+
+	b.count++
+
+	return nil
+}
+
+func (b *FSBackend) Iterate() core.Iterator {
+	return &fsIterator{file: b.file, decode: b.decode}
+}
+
+func (b *FSBackend) Len() (int, bool) {
+	return b.count, true
+}
+
+func (b *FSBackend) Replayable() bool {
+	return true
+}
+
+func (b *FSBackend) Close() error {
+	name := b.file.Name()
+
+	if err := b.file.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(name)
+}
+
+type fsIterator struct {
+	file   *os.File
+	decode Decoder
+	offset int64
+}
+
+func (iter *fsIterator) Next(_ context.Context) (core.Value, core.Value, error) {
+	var lenPrefix [4]byte
+
+	n, err := iter.file.ReadAt(lenPrefix[:], iter.offset)
+
+	if err == io.EOF || n == 0 {
+		return nil, nil, core.ErrNoMoreData
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iter.offset += int64(n)
+
+	size := binary.BigEndian.Uint32(lenPrefix[:])
+	data := make([]byte, size)
+
+	if _, err := iter.file.ReadAt(data, iter.offset); err != nil {
+		return nil, nil, err
+	}
+
+	iter.offset += int64(size)
+
+	value, err := iter.decode(data)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return value, nil, nil
+}
+
+// DecodeJSON is a convenience Decoder built on encoding/json, decoding into
+// plain Go values before handing them to parse.
+func DecodeJSON(parse func(interface{}) core.Value) Decoder {
+	return func(data []byte) (core.Value, error) {
+		var raw interface{}
+
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+
+		return parse(raw), nil
+	}
+}
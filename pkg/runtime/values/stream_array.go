@@ -0,0 +1,164 @@
+package values
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values/stream"
+)
+
+// StreamArray is a lazy, core.Iterator-backed counterpart to Array. Unlike
+// Array, it does not require every element to be materialized up front -
+// the values come from a pluggable stream.Backend (in-memory, filesystem
+// spill, or a channel fed by a concurrent producer).
+//
+// Random-access methods (Get, Slice, and Length when the backend doesn't know
+// its size) need the full sequence, so they materialize it on first use and
+// cache the result. ForEach streams directly
+// from the backend and never pays that cost, but only when the backend is
+// Replayable - a channel-fed backend can only be drained once, so ForEach
+// falls back to the (then cached) materialized sequence for it instead of
+// silently seeing nothing on a second pass.
+type StreamArray struct {
+	backend  stream.Backend
+	cached   []core.Value
+	isCached bool
+	err      error
+}
+
+// NewStreamArray creates a StreamArray reading from backend.
+func NewStreamArray(backend stream.Backend) *StreamArray {
+	return &StreamArray{backend: backend}
+}
+
+func (t *StreamArray) Type() core.Type {
+	return core.ArrayType
+}
+
+func (t *StreamArray) MarshalJSON() ([]byte, error) {
+	return NewArrayWith(t.materialize()...).MarshalJSON()
+}
+
+func (t *StreamArray) String() string {
+	return NewArrayWith(t.materialize()...).String()
+}
+
+func (t *StreamArray) Compare(other core.Value) int64 {
+	return NewArrayWith(t.materialize()...).Compare(other)
+}
+
+func (t *StreamArray) Unwrap() interface{} {
+	return NewArrayWith(t.materialize()...).Unwrap()
+}
+
+// Length returns the number of elements, materializing the backend when its
+// size isn't known up front.
+func (t *StreamArray) Length() int {
+	if n, ok := t.backend.Len(); ok {
+		return n
+	}
+
+	return len(t.materialize())
+}
+
+// Get returns the element at idx, or None when idx is out of bounds.
+func (t *StreamArray) Get(idx int) core.Value {
+	items := t.materialize()
+
+	if idx < 0 || idx >= len(items) {
+		return None
+	}
+
+	return items[idx]
+}
+
+// Slice returns a sub-slice of elements in the range [from, to).
+func (t *StreamArray) Slice(from, to int) []core.Value {
+	return NewArrayWith(t.materialize()...).Slice(from, to)
+}
+
+// Push appends value via the underlying backend.
+func (t *StreamArray) Push(value core.Value) error {
+	t.isCached = false
+	t.cached = nil
+	t.err = nil
+
+	return t.backend.Push(value)
+}
+
+// ForEach streams elements from the backend when it supports replaying
+// (MemoryBackend, FSBackend), so repeated calls never pay materialization
+// cost. Otherwise (ChannelBackend) it iterates the cached, materialized
+// sequence instead, since the backend itself can only be drained once.
+func (t *StreamArray) ForEach(predicate func(value core.Value, idx int) bool) {
+	if !t.backend.Replayable() {
+		for idx, value := range t.materialize() {
+			if !predicate(value, idx) {
+				return
+			}
+		}
+
+		return
+	}
+
+	iter := t.backend.Iterate()
+	ctx := context.Background()
+	idx := 0
+
+	for {
+		value, _, err := iter.Next(ctx)
+
+		if err != nil {
+			if err != core.ErrNoMoreData {
+				t.err = err
+			}
+
+			return
+		}
+
+		if !predicate(value, idx) {
+			return
+		}
+
+		idx++
+	}
+}
+
+// Err returns the first genuine error encountered while streaming from the
+// backend - e.g. an FSBackend disk read failure - as opposed to
+// core.ErrNoMoreData, which just signals the end of the sequence and isn't
+// an error condition. Callers that need to distinguish a short read from a
+// truncated-by-error one should check this after Length/Get/Slice/ForEach.
+func (t *StreamArray) Err() error {
+	return t.err
+}
+
+func (t *StreamArray) materialize() []core.Value {
+	if t.isCached {
+		return t.cached
+	}
+
+	var items []core.Value
+
+	iter := t.backend.Iterate()
+	ctx := context.Background()
+
+	for {
+		value, _, err := iter.Next(ctx)
+
+		if err != nil {
+			if err != core.ErrNoMoreData {
+				t.err = err
+			}
+
+			break
+		}
+
+		items = append(items, value)
+	}
+
+	t.cached = items
+	t.isCached = true
+
+	return items
+}
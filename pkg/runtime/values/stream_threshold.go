@@ -0,0 +1,22 @@
+package values
+
+// DefaultStreamThreshold is the estimated result size above which the FOR/
+// RETURN codegen should prefer a StreamArray over a fully materialized
+// Array, to avoid holding huge result sets in memory.
+const DefaultStreamThreshold = 100000
+
+// streamThreshold is configurable via SetStreamThreshold so deployments can
+// tune it (e.g. lower it on memory-constrained workers).
+var streamThreshold = DefaultStreamThreshold
+
+// SetStreamThreshold overrides the estimated-result-size threshold used by
+// ShouldStream.
+func SetStreamThreshold(threshold int) {
+	streamThreshold = threshold
+}
+
+// ShouldStream reports whether a result of the given estimated size should
+// be produced as a StreamArray instead of an Array.
+func ShouldStream(estimatedSize int) bool {
+	return estimatedSize > streamThreshold
+}
@@ -0,0 +1,82 @@
+package values
+
+import (
+	"strconv"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+type String string
+
+const EmptyString = String("")
+
+func NewString(val string) String {
+	return String(val)
+}
+
+func (t String) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(string(t))), nil
+}
+
+func (t String) Type() core.Type {
+	return core.StringType
+}
+
+func (t String) String() string {
+	return string(t)
+}
+
+func (t String) Compare(other core.Value) int64 {
+	if other.Type() != core.StringType {
+		return compareByType(t, other)
+	}
+
+	otherVal := other.(String)
+
+	if t == otherVal {
+		return 0
+	} else if t < otherVal {
+		return -1
+	}
+
+	return 1
+}
+
+func (t String) Unwrap() interface{} {
+	return string(t)
+}
+
+func (t String) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(string(t))
+}
+
+func (t *String) UnmarshalCBOR(data []byte) error {
+	var val string
+
+	if err := cbor.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*t = String(val)
+
+	return nil
+}
+
+func (t String) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal(string(t))
+}
+
+func (t *String) UnmarshalMsgpack(data []byte) error {
+	var val string
+
+	if err := msgpack.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*t = String(val)
+
+	return nil
+}
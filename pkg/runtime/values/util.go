@@ -0,0 +1,32 @@
+package values
+
+import "github.com/MontFerret/ferret/pkg/runtime/core"
+
+// typeOrder defines the relative ordering of types when comparing values of
+// different kinds, mirroring AQL-style comparison semantics.
+var typeOrder = map[core.Type]int{
+	core.NoneType:    0,
+	core.BooleanType: 1,
+	core.IntType:     2,
+	core.FloatType:   2,
+	core.StringType:  3,
+	core.BinaryType:  4,
+	core.ArrayType:   5,
+	core.ObjectType:  6,
+	core.SetType:     7,
+}
+
+// compareByType compares two values of different types by their relative
+// type precedence.
+func compareByType(this core.Value, other core.Value) int64 {
+	thisOrder := typeOrder[this.Type()]
+	otherOrder := typeOrder[other.Type()]
+
+	if thisOrder == otherOrder {
+		return 0
+	} else if thisOrder < otherOrder {
+		return -1
+	}
+
+	return 1
+}
@@ -0,0 +1,20 @@
+// Package collections implements FQL built-in functions operating on
+// Array, Object and Set values.
+package collections
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// Equal implements the FQL EQUAL(a, b) function: returns True when a and b
+// are structurally, deeply equal.
+func Equal(_ context.Context, args ...core.Value) (core.Value, error) {
+	if len(args) != 2 {
+		return values.None, core.ErrInvalidArgumentsCount
+	}
+
+	return values.NewBoolean(values.DeepEqual(args[0], args[1])), nil
+}
@@ -0,0 +1,36 @@
+package collections_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/stdlib/collections"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEqual(t *testing.T) {
+	Convey("Should return True for structurally equal arrays", t, func() {
+		out, err := collections.Equal(
+			context.Background(),
+			values.NewArrayWith(values.NewInt(1)),
+			values.NewArrayWith(values.NewInt(1)),
+		)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, values.True)
+	})
+
+	Convey("Should return False for different values", t, func() {
+		out, err := collections.Equal(context.Background(), values.NewInt(1), values.NewInt(2))
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, values.False)
+	})
+
+	Convey("Should return an error when called with the wrong arity", t, func() {
+		_, err := collections.Equal(context.Background(), values.NewInt(1))
+
+		So(err, ShouldNotBeNil)
+	})
+}
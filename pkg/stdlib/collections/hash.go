@@ -0,0 +1,17 @@
+package collections
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// Hash implements the FQL HASH(v) function: returns a stable hash of v.
+func Hash(_ context.Context, args ...core.Value) (core.Value, error) {
+	if len(args) != 1 {
+		return values.None, core.ErrInvalidArgumentsCount
+	}
+
+	return values.NewInt(int(values.Hash(args[0]))), nil
+}
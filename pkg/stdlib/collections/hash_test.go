@@ -0,0 +1,27 @@
+package collections_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/stdlib/collections"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHash(t *testing.T) {
+	Convey("Should return the same hash for equal values", t, func() {
+		a, err1 := collections.Hash(context.Background(), values.NewInt(1))
+		b, err2 := collections.Hash(context.Background(), values.NewInt(1))
+
+		So(err1, ShouldBeNil)
+		So(err2, ShouldBeNil)
+		So(a.Compare(b), ShouldEqual, 0)
+	})
+
+	Convey("Should return an error when called with the wrong arity", t, func() {
+		_, err := collections.Hash(context.Background())
+
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,37 @@
+package collections
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// Unique implements the FQL UNIQUE(arr) function: returns a new array
+// containing the distinct elements of arr, preserving first-seen order. It
+// is backed by values.Set, so deduplication is O(n) instead of the O(n^2)
+// pairwise comparison a naive implementation would need.
+func Unique(_ context.Context, args ...core.Value) (core.Value, error) {
+	if len(args) != 1 {
+		return values.None, core.ErrInvalidArgumentsCount
+	}
+
+	arr, ok := args[0].(*values.Array)
+
+	if !ok {
+		return values.None, core.ErrInvalidType
+	}
+
+	seen := values.NewSet(arr.Length())
+	out := values.NewArray(arr.Length())
+
+	arr.ForEach(func(value core.Value, idx int) bool {
+		if seen.Add(value) {
+			out.Push(value)
+		}
+
+		return true
+	})
+
+	return out, nil
+}
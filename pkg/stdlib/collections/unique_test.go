@@ -0,0 +1,39 @@
+package collections_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/stdlib/collections"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnique(t *testing.T) {
+	Convey("Should remove duplicates, preserving order", t, func() {
+		arr := values.NewArrayWith(
+			values.NewInt(1),
+			values.NewInt(2),
+			values.NewInt(1),
+			values.NewInt(3),
+		)
+
+		out, err := collections.Unique(context.Background(), arr)
+
+		So(err, ShouldBeNil)
+
+		result := out.(*values.Array)
+
+		So(result.Length(), ShouldEqual, 3)
+		So(result.Get(0).Compare(values.NewInt(1)), ShouldEqual, 0)
+		So(result.Get(1).Compare(values.NewInt(2)), ShouldEqual, 0)
+		So(result.Get(2).Compare(values.NewInt(3)), ShouldEqual, 0)
+	})
+
+	Convey("Should return an error for a non-array argument", t, func() {
+		_, err := collections.Unique(context.Background(), values.NewInt(1))
+
+		So(err, ShouldEqual, core.ErrInvalidType)
+	})
+}
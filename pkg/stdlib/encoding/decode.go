@@ -0,0 +1,36 @@
+package encoding
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// Decode implements the FQL DECODE(bytes, format) function, the inverse of
+// Encode. bytes accepts either a Binary (as produced by Encode) or a
+// String, for callers decoding data that arrived as plain text (e.g. JSON).
+func Decode(_ context.Context, args ...core.Value) (core.Value, error) {
+	if len(args) != 2 {
+		return values.None, core.ErrInvalidArgumentsCount
+	}
+
+	var data []byte
+
+	switch v := args[0].(type) {
+	case values.Binary:
+		data = []byte(v)
+	case values.String:
+		data = []byte(v)
+	default:
+		return values.None, core.ErrInvalidType
+	}
+
+	format, ok := args[1].(values.String)
+
+	if !ok {
+		return values.None, core.ErrInvalidType
+	}
+
+	return values.Decode(data, string(format))
+}
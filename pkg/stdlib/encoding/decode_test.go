@@ -0,0 +1,31 @@
+package encoding_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/stdlib/encoding"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDecode(t *testing.T) {
+	Convey("Should round-trip through ENCODE/DECODE", t, func() {
+		encoded, err := encoding.Encode(context.Background(), values.NewArrayWith(values.NewInt(1)), values.NewString("cbor"))
+		So(err, ShouldBeNil)
+
+		decoded, err := encoding.Decode(context.Background(), encoded, values.NewString("cbor"))
+		So(err, ShouldBeNil)
+
+		arr, ok := decoded.(*values.Array)
+		So(ok, ShouldBeTrue)
+		So(arr.Length(), ShouldEqual, 1)
+		So(arr.Get(0).Compare(values.NewInt(1)), ShouldEqual, 0)
+	})
+
+	Convey("Should return an error when called with the wrong arity", t, func() {
+		_, err := encoding.Decode(context.Background(), values.NewString("x"))
+
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,35 @@
+// Package encoding implements FQL built-in functions for converting values
+// to and from binary wire formats (JSON, CBOR, MessagePack).
+package encoding
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// Encode implements the FQL ENCODE(value, format) function, where format is
+// one of "json", "cbor" or "msgpack". The result is returned as a Binary,
+// not a String: cbor/msgpack output is arbitrary bytes, and String's
+// Go-syntax MarshalJSON quoting can emit escapes (e.g. \xff) that aren't
+// valid JSON, corrupting any result that leaves the engine as JSON.
+func Encode(_ context.Context, args ...core.Value) (core.Value, error) {
+	if len(args) != 2 {
+		return values.None, core.ErrInvalidArgumentsCount
+	}
+
+	format, ok := args[1].(values.String)
+
+	if !ok {
+		return values.None, core.ErrInvalidType
+	}
+
+	data, err := values.Encode(args[0], string(format))
+
+	if err != nil {
+		return values.None, err
+	}
+
+	return values.NewBinary(data), nil
+}
@@ -0,0 +1,43 @@
+package encoding_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/stdlib/encoding"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEncode(t *testing.T) {
+	Convey("Should encode a value using the given format, as Binary", t, func() {
+		out, err := encoding.Encode(context.Background(), values.NewInt(1), values.NewString("json"))
+
+		So(err, ShouldBeNil)
+		So(out, ShouldResemble, values.NewBinary([]byte("1")))
+	})
+
+	Convey("Should produce a value whose MarshalJSON output is always valid JSON, even for arbitrary bytes", t, func() {
+		out, err := encoding.Encode(context.Background(), values.NewString("x"), values.NewString("msgpack"))
+		So(err, ShouldBeNil)
+
+		marshaled, err := out.MarshalJSON()
+		So(err, ShouldBeNil)
+
+		var decoded string
+		So(json.Unmarshal(marshaled, &decoded), ShouldBeNil)
+	})
+
+	Convey("Should return an error for an unsupported format", t, func() {
+		_, err := encoding.Encode(context.Background(), values.NewInt(1), values.NewString("yaml"))
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should return an error when called with the wrong arity", t, func() {
+		_, err := encoding.Encode(context.Background(), values.NewInt(1))
+
+		So(err, ShouldNotBeNil)
+	})
+}